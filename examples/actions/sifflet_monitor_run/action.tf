@@ -0,0 +1,30 @@
+data "sifflet_monitors" "orders" {
+  filter = {
+    text_search = "orders freshness"
+  }
+}
+
+# Run a monitor after each deployment of the pipeline, and fail the apply
+# if the monitor reports an incident.
+action "sifflet_monitor_run" "orders" {
+  config {
+    monitor_id            = data.sifflet_monitors.orders.results[0].id
+    timeout_seconds       = 900
+    poll_interval_seconds = 10
+  }
+}
+
+resource "terraform_data" "pipeline_version" {
+  input = var.pipeline_version
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.sifflet_monitor_run.orders]
+    }
+  }
+}
+
+variable "pipeline_version" {
+  type = string
+}
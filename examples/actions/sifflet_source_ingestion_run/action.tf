@@ -0,0 +1,31 @@
+data "sifflet_credentials" "example" {
+  name = "example"
+}
+
+# Run the ingestion of the source right after it's created or updated,
+# and fail the apply if the ingestion fails.
+action "sifflet_source_ingestion_run" "example" {
+  config {
+    source_id           = sifflet_source_v2.example.id
+    wait_for_completion = true
+    timeout_seconds     = 3600
+  }
+}
+
+resource "sifflet_source_v2" "example" {
+  name = "example"
+  parameters = {
+    bigquery = {
+      project_id         = "project_id"
+      billing_project_id = "billing_project_id"
+      credentials        = data.sifflet_credentials.example.name
+    }
+  }
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.sifflet_source_ingestion_run.example]
+    }
+  }
+}
@@ -0,0 +1,3 @@
+data "sifflet_calendar" "example" {
+  id = "ad7b0951-318c-4950-932b-4614621b9bed"
+}
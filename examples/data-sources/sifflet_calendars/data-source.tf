@@ -0,0 +1,9 @@
+data "sifflet_calendars" "all" {}
+
+# Find a standard calendar provided by Sifflet
+locals {
+  weekends_calendar_id = one([
+    for calendar in data.sifflet_calendars.all.calendars : calendar.id
+    if calendar.standard_calendar == "WEEKENDS"
+  ])
+}
@@ -1,3 +1,8 @@
 data "sifflet_domain" "example" {
   id = "aaaabbbb-aaaa-bbbb-aaaa-bbbbaaaabbbb"
 }
+
+# Domains can also be read by name, when the name identifies a single domain.
+data "sifflet_domain" "by_name" {
+  name = "Marketing"
+}
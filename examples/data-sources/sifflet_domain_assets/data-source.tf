@@ -0,0 +1,15 @@
+data "sifflet_domain_assets" "example" {
+  domain_id = "00000000-0000-0000-0000-000000000000"
+}
+
+# Warn when a dynamic domain doesn't match any asset anymore (for instance, after a tag was renamed)
+check "domain_not_empty" {
+  assert {
+    condition     = data.sifflet_domain_assets.example.total_count > 0
+    error_message = "The domain doesn't contain any asset."
+  }
+}
+
+output "domain_asset_uris" {
+  value = [for asset in data.sifflet_domain_assets.example.results : asset.uri]
+}
@@ -0,0 +1,10 @@
+# All the domains whose name contains "finance" (case-insensitive).
+data "sifflet_domains" "finance" {
+  filter = {
+    text_search = "finance"
+  }
+}
+
+output "finance_domain_ids" {
+  value = { for domain in data.sifflet_domains.finance.results : domain.name => domain.id }
+}
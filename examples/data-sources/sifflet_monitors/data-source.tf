@@ -0,0 +1,21 @@
+data "sifflet_monitors" "example" {
+  filter = {
+    text_search   = "orders"
+    monitor_types = ["freshness"]
+  }
+  max_results = 100
+}
+
+# Dataset URIs covered by at least one monitor
+output "monitored_datasets" {
+  value = distinct(flatten([
+    for monitor in data.sifflet_monitors.example.results : [
+      for dataset in monitor.datasets : dataset.uri
+    ]
+  ]))
+}
+
+# Full monitors-as-code definitions, as YAML
+output "monitor_definitions" {
+  value = [for monitor in data.sifflet_monitors.example.results : yamlencode(jsondecode(monitor.definition))]
+}
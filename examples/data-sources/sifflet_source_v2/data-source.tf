@@ -0,0 +1,12 @@
+data "sifflet_source_v2" "by_id" {
+  id = "ad7b0951-318c-4950-932b-4614621b9bed"
+}
+
+# Sources can also be looked up by name.
+data "sifflet_source_v2" "by_name" {
+  name = "warehouse"
+}
+
+output "warehouse_project_id" {
+  value = data.sifflet_source_v2.by_name.parameters.bigquery.project_id
+}
@@ -0,0 +1,11 @@
+# All the BigQuery and Snowflake sources whose last ingestion failed.
+data "sifflet_sources_v2" "failed_warehouses" {
+  filter = {
+    source_types      = ["bigquery", "snowflake"]
+    last_run_statuses = ["FAILURE"]
+  }
+}
+
+output "failed_warehouse_names" {
+  value = [for source in data.sifflet_sources_v2.failed_warehouses.results : source.name]
+}
@@ -0,0 +1,11 @@
+# Users can be read by email, for instance users created by SSO just-in-time provisioning.
+data "sifflet_user" "example" {
+  email = "user@example.com"
+}
+
+resource "sifflet_team" "example" {
+  name = "Data engineering"
+  users = [{
+    user_id = data.sifflet_user.example.id
+  }]
+}
@@ -0,0 +1,11 @@
+# All the enabled administrators.
+data "sifflet_users" "admins" {
+  filter = {
+    roles    = ["ADMIN"]
+    statuses = ["ENABLED"]
+  }
+}
+
+output "admin_emails" {
+  value = [for user in data.sifflet_users.admins.results : user.email]
+}
@@ -0,0 +1,2 @@
+# The imported resource manages no metadata until the configuration is applied.
+terraform import sifflet_asset_metadata.orders 'snowflake://account.eu-west-1.aws/DB.SCHEMA.ORDERS'
@@ -0,0 +1,32 @@
+resource "sifflet_asset_metadata" "orders" {
+  uri         = "snowflake://account.eu-west-1.aws/DB.SCHEMA.ORDERS"
+  description = "One row per customer order, updated hourly"
+  owners = [
+    { email = "data-platform@example.com" },
+  ]
+  tags = [
+    { name = "Production" },
+  ]
+  terms = [
+    { name = "Order" },
+  ]
+  custom_metadata_values = [
+    {
+      name         = "Criticality"
+      string_value = "High"
+    },
+  ]
+  columns = [
+    {
+      name        = "ORDER_ID"
+      description = "Unique identifier of the order"
+    },
+    {
+      name        = "CUSTOMER_EMAIL"
+      description = "Email of the customer"
+      tags = [
+        { name = "PII", kind = "Classification" },
+      ]
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Import by <asset ID>/<owner ID>. Reference the owner by ID in the configuration, otherwise the resource is recreated.
+terraform import sifflet_asset_owner.orders_data_platform '1d6b2c4e-8a8f-4a57-9d0e-3f2a61b7c9e5/5c0e7f3a-2b19-4d8e-a6f1-9b3d2e7c4a10'
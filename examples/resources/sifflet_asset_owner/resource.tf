@@ -0,0 +1,19 @@
+resource "sifflet_team" "data_platform" {
+  name = "Data Platform"
+}
+
+# Attach a team as owner of an asset, without altering the other owners of the asset
+resource "sifflet_asset_owner" "orders_data_platform" {
+  uri = "snowflake://account.eu-west-1.aws/DB.SCHEMA.ORDERS"
+  owner = {
+    id = sifflet_team.data_platform.id
+  }
+}
+
+# Users can also be referenced by email
+resource "sifflet_asset_owner" "orders_analyst" {
+  uri = "snowflake://account.eu-west-1.aws/DB.SCHEMA.ORDERS"
+  owner = {
+    email = "analyst@example.com"
+  }
+}
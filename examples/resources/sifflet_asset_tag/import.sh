@@ -0,0 +1,2 @@
+# Import by <asset ID>/<tag ID>. Reference the tag by ID in the configuration, otherwise the resource is recreated.
+terraform import sifflet_asset_tag.orders_finance '1d6b2c4e-8a8f-4a57-9d0e-3f2a61b7c9e5/ad7b0951-318c-4950-932b-4614621b9bed'
@@ -0,0 +1,20 @@
+resource "sifflet_tag" "finance" {
+  name = "Finance"
+}
+
+# Attach a tag to an asset, without altering the other tags of the asset
+resource "sifflet_asset_tag" "orders_finance" {
+  uri = "snowflake://account.eu-west-1.aws/DB.SCHEMA.ORDERS"
+  tag = {
+    id = sifflet_tag.finance.id
+  }
+}
+
+# Tags can also be referenced by name (and kind, when the name is ambiguous)
+resource "sifflet_asset_tag" "orders_pii" {
+  uri = "snowflake://account.eu-west-1.aws/DB.SCHEMA.ORDERS"
+  tag = {
+    name = "PII"
+    kind = "Classification"
+  }
+}
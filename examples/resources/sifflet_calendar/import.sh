@@ -0,0 +1 @@
+terraform import sifflet_calendar.example 'ad7b0951-318c-4950-932b-4614621b9bed'
@@ -0,0 +1,17 @@
+resource "sifflet_calendar" "example" {
+  name        = "Company holidays"
+  description = "Days when the data platform is not expected to be updated"
+  timeslots = [
+    {
+      date        = "2026-12-24"
+      description = "Christmas Eve"
+    },
+    {
+      date        = "2026-12-25"
+      description = "Christmas Day"
+    },
+    {
+      date = "2026-12-31"
+    },
+  ]
+}
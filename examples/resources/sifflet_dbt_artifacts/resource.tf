@@ -0,0 +1,19 @@
+resource "sifflet_source_v2" "dbt" {
+  name = "dbt - analytics"
+  parameters = {
+    dbt = {
+      project_name = "analytics"
+      target       = "prod"
+    }
+  }
+}
+
+# Upload the artifacts generated by `dbt build` and `dbt docs generate`.
+# The files are uploaded again whenever their content changes.
+resource "sifflet_dbt_artifacts" "analytics" {
+  project_name     = sifflet_source_v2.dbt.parameters.dbt.project_name
+  target           = sifflet_source_v2.dbt.parameters.dbt.target
+  manifest_path    = "${path.module}/target/manifest.json"
+  catalog_path     = "${path.module}/target/catalog.json"
+  run_results_path = "${path.module}/target/run_results.json"
+}
@@ -0,0 +1,23 @@
+resource "sifflet_declared_asset" "example" {
+  uri         = "api://billing.example.com/v1/orders"
+  name        = "Billing API - orders"
+  type        = "Generic"
+  sub_type    = "API"
+  href        = "https://billing.example.com/docs/orders"
+  description = "Orders exposed by the billing API"
+  tags = [
+    { name = "Production" },
+  ]
+  owners = [
+    { email = "data-platform@example.com" },
+  ]
+  terms = [
+    { name = "Order" },
+  ]
+  custom_metadata_values = [
+    {
+      name         = "Criticality"
+      string_value = "High"
+    },
+  ]
+}
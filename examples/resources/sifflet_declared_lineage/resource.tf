@@ -0,0 +1,11 @@
+resource "sifflet_declared_asset" "crm_sync" {
+  uri  = "api://reverse-etl.example.com/syncs/crm_accounts"
+  name = "CRM accounts sync"
+  type = "Pipeline"
+}
+
+# Lineage links can reference declared assets or assets ingested from a source
+resource "sifflet_declared_lineage" "accounts_to_crm_sync" {
+  from = "snowflake://account.eu-west-1.aws/DB.SCHEMA.ACCOUNTS"
+  to   = sifflet_declared_asset.crm_sync.uri
+}
@@ -52,3 +52,35 @@ resource "sifflet_domain" "example" {
     }]
   }
 }
+
+# --- Example of a domain containing all the assets of a source ---
+
+resource "sifflet_domain" "finance" {
+  name = "Finance"
+  dynamic_content_definition = {
+    logical_operator = "AND"
+    conditions = [{
+      logical_operator = "IS"
+      sources = [{
+        name = "Finance Snowflake"
+      }]
+    }]
+  }
+}
+
+# --- Example of a domain granting roles to teams ---
+
+resource "sifflet_team" "example" {
+  name = "Example team"
+}
+
+resource "sifflet_domain" "with_team_permissions" {
+  name = "Team permissions example"
+  static_content_definition = {
+    asset_uris = [data.sifflet_asset.example_by_uri.uri]
+  }
+  team_permissions = [{
+    team_id     = sifflet_team.example.id
+    domain_role = "EDITOR"
+  }]
+}
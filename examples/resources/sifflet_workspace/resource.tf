@@ -0,0 +1,40 @@
+resource "sifflet_workspace" "example" {
+  name = "homegrown-pipelines"
+
+  sources = [{
+    uri         = "airflow://airflow.example.com"
+    name        = "Internal Airflow"
+    description = "Self-hosted Airflow instance"
+  }]
+
+  assets = [
+    {
+      uri         = "airflow://airflow.example.com/dags/load_orders"
+      name        = "load_orders"
+      type        = "Pipeline"
+      description = "Loads orders from the billing API"
+      href        = "https://airflow.example.com/dags/load_orders"
+      tags = [{
+        name = "Production"
+      }]
+    },
+    {
+      uri      = "api://billing.example.com/v1/orders"
+      name     = "Billing API - orders"
+      type     = "Generic"
+      sub_type = "API"
+    },
+  ]
+
+  # Lineage links can reference declared assets or assets ingested from a source
+  lineages = [
+    {
+      from = "api://billing.example.com/v1/orders"
+      to   = "airflow://airflow.example.com/dags/load_orders"
+    },
+    {
+      from = "airflow://airflow.example.com/dags/load_orders"
+      to   = "snowflake://account.eu-west-1.aws/DB.SCHEMA.ORDERS"
+    },
+  ]
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/oapi-codegen/runtime/types"
 	"gopkg.in/validator.v2"
@@ -57,16 +58,103 @@ func (t PublicEditSourceV2JSONRequestBody) MarshalJSON() ([]byte, error) {
 	return b, err
 }
 
+// Legacy source types, indexed by v2 source type. Only the source types whose legacy parameters
+// can all be derived from the v2 connection settings are listed here. The other source types
+// (e.g. SNOWFLAKE, whose legacy parameters require a database and a schema) can't be tested
+// through the test-connection route.
+var testConnectionSourceTypes = map[string]string{
+	"AIRFLOW":    "AIRFLOW",
+	"DBT":        "DBT",
+	"FIVETRAN":   "FIVETRAN",
+	"LOOKER":     "LOOKER",
+	"MYSQL":      "MYSQL",
+	"QUICKSIGHT": "QUICKSIGHT",
+	"TABLEAU":    "TABLEAU",
+}
+
+// SupportsTestConnection returns true if the connection of sources of the given v2 type (e.g. "MYSQL")
+// can be tested with ToTestConnectionDto.
+func SupportsTestConnection(sourceType string) bool {
+	_, ok := testConnectionSourceTypes[sourceType]
+	return ok
+}
+
+// ToTestConnectionDto converts a v2 source body to the legacy source format (PublicCreateSourceDto),
+// which is the only format accepted by the test-connection route. In this format, the connection
+// parameters are a flat object with a type discriminator.
+// For the source types supported by this conversion (see SupportsTestConnection), the legacy parameters
+// have the same names as the v2 connection settings (e.g. `mysqlInformation`), so the conversion only
+// needs to move them and to map the source type. Optional v2 settings that are required by the legacy
+// format are set to their documented default.
+func (b PublicCreateSourceV2JSONBody) ToTestConnectionDto() (PublicCreateSourceDto, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b.union, &fields); err != nil {
+		return PublicCreateSourceDto{}, err
+	}
+
+	var common struct {
+		Name        string  `json:"name"`
+		Type        string  `json:"type"`
+		Credentials *string `json:"credentials,omitempty"`
+		Schedule    *string `json:"schedule,omitempty"`
+	}
+	if err := json.Unmarshal(b.union, &common); err != nil {
+		return PublicCreateSourceDto{}, err
+	}
+
+	legacyType, ok := testConnectionSourceTypes[common.Type]
+	if !ok {
+		return PublicCreateSourceDto{}, fmt.Errorf("testing the connection of %s sources is not supported", common.Type)
+	}
+
+	parameters := map[string]json.RawMessage{}
+	for key, value := range fields {
+		if strings.HasSuffix(key, "Information") {
+			if err := json.Unmarshal(value, &parameters); err != nil {
+				return PublicCreateSourceDto{}, err
+			}
+		}
+	}
+	typeJson, err := json.Marshal(legacyType)
+	if err != nil {
+		return PublicCreateSourceDto{}, err
+	}
+	parameters["type"] = typeJson
+	if _, ok := parameters["gitConnections"]; legacyType == "LOOKER" && !ok {
+		// The legacy Looker parameters require the LookML configuration, which defaults to an empty list in v2.
+		parameters["gitConnections"] = json.RawMessage("[]")
+	}
+	parametersJson, err := json.Marshal(parameters)
+	if err != nil {
+		return PublicCreateSourceDto{}, err
+	}
+
+	dto := PublicCreateSourceDto{
+		Name:        common.Name,
+		Credentials: common.Credentials,
+		Schedule:    common.Schedule,
+	}
+	if err := dto.Parameters.UnmarshalJSON(parametersJson); err != nil {
+		return PublicCreateSourceDto{}, err
+	}
+	return dto, nil
+}
+
 // Interface for parameters that are common to
 // all DTOs returned by the PublicGetSourceV2 route.
 type PublicGetSourceV2 interface {
 	GetType() string
 	GetId() types.UUID
 	GetName() string
+	GetLastRun() *PublicGetLastRunV2Dto
 }
 
 // ID
 
+func (obj PublicGetAdfSourceV2Dto) GetId() types.UUID {
+	return *obj.Id
+}
+
 func (obj PublicGetAirflowSourceV2Dto) GetId() types.UUID {
 	return *obj.Id
 }
@@ -83,6 +171,10 @@ func (obj PublicGetDatabricksSourceV2Dto) GetId() types.UUID {
 	return *obj.Id
 }
 
+func (obj PublicGetDatabricksJobsSourceV2Dto) GetId() types.UUID {
+	return *obj.Id
+}
+
 func (obj PublicGetDbtCloudSourceV2Dto) GetId() types.UUID {
 	return *obj.Id
 }
@@ -149,6 +241,10 @@ func (obj PublicGetTableauSourceV2Dto) GetId() types.UUID {
 
 // Type
 
+func (obj PublicGetAdfSourceV2Dto) GetType() string {
+	return string(obj.Type)
+}
+
 func (obj PublicGetAirflowSourceV2Dto) GetType() string {
 	return string(obj.Type)
 }
@@ -165,6 +261,10 @@ func (obj PublicGetDatabricksSourceV2Dto) GetType() string {
 	return string(obj.Type)
 }
 
+func (obj PublicGetDatabricksJobsSourceV2Dto) GetType() string {
+	return string(obj.Type)
+}
+
 func (obj PublicGetDbtCloudSourceV2Dto) GetType() string {
 	return string(obj.Type)
 }
@@ -231,6 +331,10 @@ func (obj PublicGetTableauSourceV2Dto) GetType() string {
 
 // Name
 
+func (obj PublicGetAdfSourceV2Dto) GetName() string {
+	return obj.Name
+}
+
 func (obj PublicGetAirflowSourceV2Dto) GetName() string {
 	return obj.Name
 }
@@ -247,6 +351,10 @@ func (obj PublicGetDatabricksSourceV2Dto) GetName() string {
 	return obj.Name
 }
 
+func (obj PublicGetDatabricksJobsSourceV2Dto) GetName() string {
+	return obj.Name
+}
+
 func (obj PublicGetDbtCloudSourceV2Dto) GetName() string {
 	return obj.Name
 }
@@ -311,34 +419,145 @@ func (obj PublicGetTableauSourceV2Dto) GetName() string {
 	return obj.Name
 }
 
+// LastRun
+
+func (obj PublicGetAdfSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetAirflowSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetAthenaSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetBigQuerySourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetDatabricksSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetDatabricksJobsSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetDbtCloudSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetDbtSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetFivetranSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetLookerSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetMicrostrategySourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetMssqlSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetMysqlSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetOracleSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetPostgresqlSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetPowerBiSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetQlikSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetQuicksightSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetRedshiftSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetSnowflakeSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetSynapseSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
+func (obj PublicGetTableauSourceV2Dto) GetLastRun() *PublicGetLastRunV2Dto {
+	return obj.LastRun
+}
+
 // SiffletPublicGetSourceV2Dto reprensents the `oneOf` structure of the PublicGetSourceV2Dto object.
 type SiffletPublicGetSourceV2Dto struct {
-	PublicGetAirflowSourceV2Dto       *PublicGetAirflowSourceV2Dto
-	PublicGetAthenaSourceV2Dto        *PublicGetAthenaSourceV2Dto
-	PublicGetBigQuerySourceV2Dto      *PublicGetBigQuerySourceV2Dto
-	PublicGetDatabricksSourceV2Dto    *PublicGetDatabricksSourceV2Dto
-	PublicGetDbtCloudSourceV2Dto      *PublicGetDbtCloudSourceV2Dto
-	PublicGetDbtSourceV2Dto           *PublicGetDbtSourceV2Dto
-	PublicGetFivetranSourceV2Dto      *PublicGetFivetranSourceV2Dto
-	PublicGetLookerSourceV2Dto        *PublicGetLookerSourceV2Dto
-	PublicGetMicrostrategySourceV2Dto *PublicGetMicrostrategySourceV2Dto
-	PublicGetMssqlSourceV2Dto         *PublicGetMssqlSourceV2Dto
-	PublicGetMysqlSourceV2Dto         *PublicGetMysqlSourceV2Dto
-	PublicGetOracleSourceV2Dto        *PublicGetOracleSourceV2Dto
-	PublicGetPostgresqlSourceV2Dto    *PublicGetPostgresqlSourceV2Dto
-	PublicGetPowerBiSourceV2Dto       *PublicGetPowerBiSourceV2Dto
-	PublicGetQlikSourceV2Dto          *PublicGetQlikSourceV2Dto
-	PublicGetQuicksightSourceV2Dto    *PublicGetQuicksightSourceV2Dto
-	PublicGetRedshiftSourceV2Dto      *PublicGetRedshiftSourceV2Dto
-	PublicGetSnowflakeSourceV2Dto     *PublicGetSnowflakeSourceV2Dto
-	PublicGetSynapseSourceV2Dto       *PublicGetSynapseSourceV2Dto
-	PublicGetTableauSourceV2Dto       *PublicGetTableauSourceV2Dto
+	PublicGetAdfSourceV2Dto            *PublicGetAdfSourceV2Dto
+	PublicGetAirflowSourceV2Dto        *PublicGetAirflowSourceV2Dto
+	PublicGetAthenaSourceV2Dto         *PublicGetAthenaSourceV2Dto
+	PublicGetBigQuerySourceV2Dto       *PublicGetBigQuerySourceV2Dto
+	PublicGetDatabricksSourceV2Dto     *PublicGetDatabricksSourceV2Dto
+	PublicGetDatabricksJobsSourceV2Dto *PublicGetDatabricksJobsSourceV2Dto
+	PublicGetDbtCloudSourceV2Dto       *PublicGetDbtCloudSourceV2Dto
+	PublicGetDbtSourceV2Dto            *PublicGetDbtSourceV2Dto
+	PublicGetFivetranSourceV2Dto       *PublicGetFivetranSourceV2Dto
+	PublicGetLookerSourceV2Dto         *PublicGetLookerSourceV2Dto
+	PublicGetMicrostrategySourceV2Dto  *PublicGetMicrostrategySourceV2Dto
+	PublicGetMssqlSourceV2Dto          *PublicGetMssqlSourceV2Dto
+	PublicGetMysqlSourceV2Dto          *PublicGetMysqlSourceV2Dto
+	PublicGetOracleSourceV2Dto         *PublicGetOracleSourceV2Dto
+	PublicGetPostgresqlSourceV2Dto     *PublicGetPostgresqlSourceV2Dto
+	PublicGetPowerBiSourceV2Dto        *PublicGetPowerBiSourceV2Dto
+	PublicGetQlikSourceV2Dto           *PublicGetQlikSourceV2Dto
+	PublicGetQuicksightSourceV2Dto     *PublicGetQuicksightSourceV2Dto
+	PublicGetRedshiftSourceV2Dto       *PublicGetRedshiftSourceV2Dto
+	PublicGetSnowflakeSourceV2Dto      *PublicGetSnowflakeSourceV2Dto
+	PublicGetSynapseSourceV2Dto        *PublicGetSynapseSourceV2Dto
+	PublicGetTableauSourceV2Dto        *PublicGetTableauSourceV2Dto
 }
 
 // Unmarshal JSON data into one and only one of the pointers in the struct.
 func (dst *SiffletPublicGetSourceV2Dto) UnmarshalJSON(data []byte) error {
 	var err error
 	match := 0
+	// try to unmarshal data into PublicGetAdfSourceV2Dto
+	err = json.NewDecoder(bytes.NewBuffer(data)).Decode(&dst.PublicGetAdfSourceV2Dto)
+	if err == nil {
+		jsonPublicGetAdfSourceV2Dto, _ := json.Marshal(dst.PublicGetAdfSourceV2Dto)
+		if string(jsonPublicGetAdfSourceV2Dto) == "{}" { // empty struct
+			dst.PublicGetAdfSourceV2Dto = nil
+		} else {
+			if err = validator.Validate(dst.PublicGetAdfSourceV2Dto); err != nil {
+				dst.PublicGetAdfSourceV2Dto = nil
+			} else if dst.PublicGetAdfSourceV2Dto.Type != PublicGetAdfSourceV2DtoTypeADF {
+				dst.PublicGetAdfSourceV2Dto = nil
+			} else {
+				match++
+			}
+		}
+	} else {
+		dst.PublicGetAdfSourceV2Dto = nil
+	}
+
 	// try to unmarshal data into PublicGetAirflowSourceV2Dto
 	err = json.NewDecoder(bytes.NewBuffer(data)).Decode(&dst.PublicGetAirflowSourceV2Dto)
 	if err == nil {
@@ -415,6 +634,25 @@ func (dst *SiffletPublicGetSourceV2Dto) UnmarshalJSON(data []byte) error {
 		dst.PublicGetDatabricksSourceV2Dto = nil
 	}
 
+	// try to unmarshal data into PublicGetDatabricksJobsSourceV2Dto
+	err = json.NewDecoder(bytes.NewBuffer(data)).Decode(&dst.PublicGetDatabricksJobsSourceV2Dto)
+	if err == nil {
+		jsonPublicGetDatabricksJobsSourceV2Dto, _ := json.Marshal(dst.PublicGetDatabricksJobsSourceV2Dto)
+		if string(jsonPublicGetDatabricksJobsSourceV2Dto) == "{}" { // empty struct
+			dst.PublicGetDatabricksJobsSourceV2Dto = nil
+		} else {
+			if err = validator.Validate(dst.PublicGetDatabricksJobsSourceV2Dto); err != nil {
+				dst.PublicGetDatabricksJobsSourceV2Dto = nil
+			} else if dst.PublicGetDatabricksJobsSourceV2Dto.Type != PublicGetDatabricksJobsSourceV2DtoTypeDATABRICKSJOBS {
+				dst.PublicGetDatabricksJobsSourceV2Dto = nil
+			} else {
+				match++
+			}
+		}
+	} else {
+		dst.PublicGetDatabricksJobsSourceV2Dto = nil
+	}
+
 	// try to unmarshal data into PublicGetDbtCloudSourceV2Dto
 	err = json.NewDecoder(bytes.NewBuffer(data)).Decode(&dst.PublicGetDbtCloudSourceV2Dto)
 	if err == nil {
@@ -721,10 +959,12 @@ func (dst *SiffletPublicGetSourceV2Dto) UnmarshalJSON(data []byte) error {
 
 	if match > 1 {
 		// If more than 1 match, reset all the pointers to nil.
+		dst.PublicGetAdfSourceV2Dto = nil
 		dst.PublicGetAirflowSourceV2Dto = nil
 		dst.PublicGetAthenaSourceV2Dto = nil
 		dst.PublicGetBigQuerySourceV2Dto = nil
 		dst.PublicGetDatabricksSourceV2Dto = nil
+		dst.PublicGetDatabricksJobsSourceV2Dto = nil
 		dst.PublicGetDbtCloudSourceV2Dto = nil
 		dst.PublicGetDbtSourceV2Dto = nil
 		dst.PublicGetFivetranSourceV2Dto = nil
@@ -752,6 +992,10 @@ func (dst *SiffletPublicGetSourceV2Dto) UnmarshalJSON(data []byte) error {
 
 // Gets the specific source dto from the SiffletPublicGetSourceV2Dto, that implements the PublicGetSourceV2 interface.
 func (obj SiffletPublicGetSourceV2Dto) GetSourceDto() (PublicGetSourceV2, error) {
+	if obj.PublicGetAdfSourceV2Dto != nil {
+		return *obj.PublicGetAdfSourceV2Dto, nil
+	}
+
 	if obj.PublicGetAirflowSourceV2Dto != nil {
 		return *obj.PublicGetAirflowSourceV2Dto, nil
 	}
@@ -768,6 +1012,10 @@ func (obj SiffletPublicGetSourceV2Dto) GetSourceDto() (PublicGetSourceV2, error)
 		return *obj.PublicGetDatabricksSourceV2Dto, nil
 	}
 
+	if obj.PublicGetDatabricksJobsSourceV2Dto != nil {
+		return *obj.PublicGetDatabricksJobsSourceV2Dto, nil
+	}
+
 	if obj.PublicGetDbtCloudSourceV2Dto != nil {
 		return *obj.PublicGetDbtCloudSourceV2Dto, nil
 	}
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestToTestConnectionDto(t *testing.T) {
+	credentials := "credentials"
+	tests := []struct {
+		name string
+		dto  any
+		// Fields of the legacy parameters. They must include all the required fields declared by the API.
+		expectedParameters []string
+	}{
+		{
+			name: "airflow",
+			dto: PublicCreateAirflowSourceV2Dto{
+				Name:               "airflow",
+				Type:               PublicCreateAirflowSourceV2DtoTypeAIRFLOW,
+				Credentials:        &credentials,
+				AirflowInformation: &AirflowInformation{Host: "http://airflow", Port: 8080},
+			},
+			expectedParameters: []string{"host", "port", "type"},
+		},
+		{
+			name: "dbt",
+			dto: PublicCreateDbtSourceV2Dto{
+				Name:           "dbt",
+				Type:           PublicCreateDbtSourceV2DtoTypeDBT,
+				DbtInformation: &DbtInformation{ProjectName: "project", Target: "target"},
+			},
+			expectedParameters: []string{"projectName", "target", "type"},
+		},
+		{
+			name: "fivetran",
+			dto: PublicCreateFivetranSourceV2Dto{
+				Name:                "fivetran",
+				Type:                PublicCreateFivetranSourceV2DtoTypeFIVETRAN,
+				Credentials:         &credentials,
+				FivetranInformation: &FivetranInformation{Host: "https://api.fivetran.com"},
+			},
+			expectedParameters: []string{"host", "type"},
+		},
+		{
+			name: "looker",
+			dto: PublicCreateLookerSourceV2Dto{
+				Name:              "looker",
+				Type:              PublicCreateLookerSourceV2DtoTypeLOOKER,
+				Credentials:       &credentials,
+				LookerInformation: &LookerInformation{Host: "https://looker/api/4.0"},
+			},
+			expectedParameters: []string{"gitConnections", "host", "type"},
+		},
+		{
+			name: "mysql",
+			dto: PublicCreateMysqlSourceV2Dto{
+				Name:        "mysql",
+				Type:        PublicCreateMysqlSourceV2DtoTypeMYSQL,
+				Credentials: &credentials,
+				MysqlInformation: &MysqlInformation{
+					Database:        "db",
+					Host:            "mysql",
+					Port:            3306,
+					MysqlTlsVersion: MysqlInformationMysqlTlsVersionTLSV12,
+				},
+			},
+			expectedParameters: []string{"database", "host", "mysqlTlsVersion", "port", "type"},
+		},
+		{
+			name: "quicksight",
+			dto: PublicCreateQuicksightSourceV2Dto{
+				Name:                  "quicksight",
+				Type:                  PublicCreateQuicksightSourceV2DtoTypeQUICKSIGHT,
+				QuicksightInformation: &QuicksightInformation{AccountId: "123", AwsRegion: "eu-west-1", RoleArn: "arn:aws:iam::123:role/sifflet"},
+			},
+			expectedParameters: []string{"accountId", "awsRegion", "roleArn", "type"},
+		},
+		{
+			name: "tableau",
+			dto: PublicCreateTableauSourceV2Dto{
+				Name:               "tableau",
+				Type:               PublicCreateTableauSourceV2DtoTypeTABLEAU,
+				Credentials:        &credentials,
+				TableauInformation: &TableauInformation{Host: "https://tableau", Site: "site"},
+			},
+			expectedParameters: []string{"host", "site", "type"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var body PublicCreateSourceV2JSONBody
+			if err := body.FromAny(test.dto); err != nil {
+				t.Fatalf("Failed to create body: %v", err)
+			}
+
+			dto, err := body.ToTestConnectionDto()
+			if err != nil {
+				t.Fatalf("Failed to convert body: %v", err)
+			}
+			if dto.Name != test.name {
+				t.Errorf("Expected name %q, got %q", test.name, dto.Name)
+			}
+
+			parametersJson, err := dto.Parameters.MarshalJSON()
+			if err != nil {
+				t.Fatalf("Failed to marshal parameters: %v", err)
+			}
+			var parameters map[string]json.RawMessage
+			if err := json.Unmarshal(parametersJson, &parameters); err != nil {
+				t.Fatalf("Failed to unmarshal parameters: %v", err)
+			}
+			for _, field := range test.expectedParameters {
+				if _, ok := parameters[field]; !ok {
+					t.Errorf("Missing parameter %q in %s", field, parametersJson)
+				}
+			}
+			for field := range parameters {
+				if !slices.Contains(test.expectedParameters, field) {
+					t.Errorf("Unexpected parameter %q in %s", field, parametersJson)
+				}
+			}
+
+			var legacyType string
+			if err := json.Unmarshal(parameters["type"], &legacyType); err != nil {
+				t.Fatalf("Failed to read parameters type: %v", err)
+			}
+			if legacyType != strings.ToUpper(test.name) {
+				t.Errorf("Expected parameters type %q, got %q", strings.ToUpper(test.name), legacyType)
+			}
+		})
+	}
+}
+
+func TestToTestConnectionDtoUnsupportedType(t *testing.T) {
+	var body PublicCreateSourceV2JSONBody
+	err := body.FromAny(PublicCreateSnowflakeSourceV2Dto{
+		Name:                 "snowflake",
+		Type:                 PublicCreateSnowflakeSourceV2DtoTypeSNOWFLAKE,
+		SnowflakeInformation: &SnowflakeInformation{AccountIdentifier: "account", Warehouse: "warehouse"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create body: %v", err)
+	}
+
+	if SupportsTestConnection("SNOWFLAKE") {
+		t.Errorf("Expected SNOWFLAKE sources not to support connection tests")
+	}
+	if _, err := body.ToTestConnectionDto(); err == nil {
+		t.Errorf("Expected an error when converting a SNOWFLAKE source")
+	}
+}
@@ -0,0 +1,82 @@
+package asset
+
+import (
+	"context"
+	"net/http"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// GetAsset reads an asset by URI. found is false if the asset doesn't exist.
+func GetAsset(ctx context.Context, client *sifflet.ClientWithResponses, uri string, summary string) (dto sifflet.PublicGetAssetDto, found bool, diags diag.Diagnostics) {
+	assetResponse, err := client.PublicGetAssetWithResponse(ctx, sifflet.PublicGetAssetRequestDto{Uri: uri})
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return sifflet.PublicGetAssetDto{}, false, diags
+	}
+
+	if assetResponse.StatusCode() == http.StatusNotFound {
+		return sifflet.PublicGetAssetDto{}, false, diags
+	}
+
+	if assetResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, assetResponse.StatusCode(), assetResponse.Body)
+		return sifflet.PublicGetAssetDto{}, false, diags
+	}
+
+	return *assetResponse.JSON200, true, diags
+}
+
+// editAsset updates the metadata of an asset, and returns the updated asset. Metadata that is nil in the body is left untouched.
+func editAsset(ctx context.Context, client *sifflet.ClientWithResponses, body sifflet.PublicUpdateAssetDto, summary string) (sifflet.PublicGetAssetDto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	editResponse, err := client.PublicEditAssetWithResponse(ctx, body)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return sifflet.PublicGetAssetDto{}, diags
+	}
+
+	if editResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, editResponse.StatusCode(), editResponse.Body)
+		return sifflet.PublicGetAssetDto{}, diags
+	}
+
+	return *editResponse.JSON200, diags
+}
+
+// findAssetUri returns the URI of the asset with the given ID. The API can only read assets by URI: the asset is searched
+// among the assets matching the filter, which should be as narrow as possible.
+func findAssetUri(ctx context.Context, client *sifflet.ClientWithResponses, id uuid.UUID, filter sifflet.PublicAssetFilterDto, summary string) (uri string, found bool, diags diag.Diagnostics) {
+	var itemsPerPage int32 = 100
+	for page := int32(0); ; page++ {
+		searchResponse, err := client.PublicGetAssetsWithResponse(ctx, sifflet.PublicAssetSearchCriteriaDto{
+			Filter: &filter,
+			Pagination: &sifflet.PublicAssetPaginationDto{
+				ItemsPerPage: &itemsPerPage,
+				Page:         &page,
+			},
+		})
+		if err != nil {
+			diags.AddError(summary, err.Error())
+			return "", false, diags
+		}
+		if searchResponse.StatusCode() != http.StatusOK {
+			sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, searchResponse.StatusCode(), searchResponse.Body)
+			return "", false, diags
+		}
+
+		for _, assetDto := range searchResponse.JSON200.Data {
+			if assetDto.Id == id {
+				return assetDto.Uri, true, diags
+			}
+		}
+		if int32(len(searchResponse.JSON200.Data)) < itemsPerPage { // nolint: gosec
+			// Last page
+			return "", false, diags
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// The sifflet_asset_tag and sifflet_asset_owner resources attach a single item (a tag or an owner) to an asset,
+// without altering the other items of the asset. The functions below implement their shared lifecycle.
+// The ID of these resources is made of the asset ID and of the item ID, separated by a slash.
+
+// assetAttachmentModel is the state of a resource attaching a single item to an asset.
+type assetAttachmentModel interface {
+	getUri() string
+	// findAttached returns the ID of the item of the asset matching the configured item. found is false if the asset doesn't have this item.
+	findAttached(ctx context.Context, dto sifflet.PublicGetAssetDto) (id string, found bool, diags diag.Diagnostics)
+	// attachDto returns the update adding the configured item to the asset. The other items of the asset are sent back, so that they are preserved.
+	attachDto(ctx context.Context, dto sifflet.PublicGetAssetDto) (sifflet.PublicUpdateAssetDto, diag.Diagnostics)
+	// detachDto returns the update removing the item with the given ID from the asset. The other items of the asset are preserved.
+	detachDto(dto sifflet.PublicGetAssetDto, id string) (sifflet.PublicUpdateAssetDto, diag.Diagnostics)
+}
+
+// attachToAsset attaches the configured item to the asset, and returns the ID of the resource.
+// It fails if the item is already attached to the asset: destroying the resource would then remove an item that it didn't add.
+// noun names the attached item in error messages.
+func attachToAsset(ctx context.Context, client *sifflet.ClientWithResponses, m assetAttachmentModel, noun string) (string, diag.Diagnostics) {
+	summary := fmt.Sprintf("Unable to attach %s to asset", noun)
+
+	assetDto, found, diags := GetAsset(ctx, client, m.getUri(), summary)
+	if diags.HasError() {
+		return "", diags
+	}
+	if !found {
+		diags.AddError(summary, fmt.Sprintf("Asset %s not found", m.getUri()))
+		return "", diags
+	}
+
+	itemId, found, diags := m.findAttached(ctx, assetDto)
+	if diags.HasError() {
+		return "", diags
+	}
+	if found {
+		diags.AddError(
+			summary,
+			fmt.Sprintf("The %s is already attached to asset %s. Import it instead, with the ID %s/%s.", noun, m.getUri(), assetDto.Id, itemId),
+		)
+		return "", diags
+	}
+
+	updateDto, diags := m.attachDto(ctx, assetDto)
+	if diags.HasError() {
+		return "", diags
+	}
+	assetDto, diags = editAsset(ctx, client, updateDto, summary)
+	if diags.HasError() {
+		return "", diags
+	}
+
+	itemId, found, diags = m.findAttached(ctx, assetDto)
+	if diags.HasError() {
+		return "", diags
+	}
+	if !found {
+		diags.AddError(summary, fmt.Sprintf("The %s was not found on the asset after the update", noun))
+		return "", diags
+	}
+
+	return assetDto.Id.String() + "/" + itemId, diags
+}
+
+// isAttachedToAsset returns true if the asset exists and the configured item is still attached to it.
+func isAttachedToAsset(ctx context.Context, client *sifflet.ClientWithResponses, m assetAttachmentModel, noun string) (bool, diag.Diagnostics) {
+	summary := fmt.Sprintf("Unable to read asset %s", noun)
+
+	assetDto, found, diags := GetAsset(ctx, client, m.getUri(), summary)
+	if diags.HasError() || !found {
+		return false, diags
+	}
+
+	_, found, diags = m.findAttached(ctx, assetDto)
+	return found, diags
+}
+
+// detachFromAsset removes the configured item from the asset. Nothing is done if the asset or the item no longer exist.
+func detachFromAsset(ctx context.Context, client *sifflet.ClientWithResponses, m assetAttachmentModel, noun string) diag.Diagnostics {
+	summary := fmt.Sprintf("Unable to detach %s from asset", noun)
+
+	assetDto, found, diags := GetAsset(ctx, client, m.getUri(), summary)
+	if diags.HasError() || !found {
+		return diags
+	}
+
+	itemId, found, diags := m.findAttached(ctx, assetDto)
+	if diags.HasError() || !found {
+		return diags
+	}
+
+	updateDto, diags := m.detachDto(assetDto, itemId)
+	if diags.HasError() {
+		return diags
+	}
+	_, diags = editAsset(ctx, client, updateDto, summary)
+	return diags
+}
+
+// findAttachedAssetUri parses the ID of an attachment resource, made of the asset ID and of the item ID, and returns the URI of the asset.
+// filter returns a search filter on the assets to which the item is attached.
+func findAttachedAssetUri(
+	ctx context.Context, client *sifflet.ClientWithResponses, id string, filter func(itemId uuid.UUID) sifflet.PublicAssetFilterDto, noun string,
+) (uri string, itemId uuid.UUID, diags diag.Diagnostics) {
+	summary := fmt.Sprintf("Unable to import asset %s", noun)
+
+	assetIdStr, itemIdStr, ok := strings.Cut(id, "/")
+	if !ok {
+		diags.AddError(summary, fmt.Sprintf("Expected an ID of the form <asset ID>/<%s ID>, got %q", noun, id))
+		return "", uuid.Nil, diags
+	}
+	assetId, err := uuid.Parse(assetIdStr)
+	if err != nil {
+		diags.AddError(summary, fmt.Sprintf("Could not parse asset ID %q as UUID: %s", assetIdStr, err))
+		return "", uuid.Nil, diags
+	}
+	itemId, err = uuid.Parse(itemIdStr)
+	if err != nil {
+		diags.AddError(summary, fmt.Sprintf("Could not parse %s ID %q as UUID: %s", noun, itemIdStr, err))
+		return "", uuid.Nil, diags
+	}
+
+	uri, found, diags := findAssetUri(ctx, client, assetId, filter(itemId), summary)
+	if diags.HasError() {
+		return "", uuid.Nil, diags
+	}
+	if !found {
+		diags.AddError(summary, fmt.Sprintf("No asset with ID %s and %s %s was found", assetId, noun, itemId))
+		return "", uuid.Nil, diags
+	}
+	return uri, itemId, diags
+}
@@ -0,0 +1,241 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+var (
+	_ resource.Resource                = &assetMetadataResource{}
+	_ resource.ResourceWithConfigure   = &assetMetadataResource{}
+	_ resource.ResourceWithImportState = &assetMetadataResource{}
+)
+
+func newAssetMetadataResource() resource.Resource {
+	return &assetMetadataResource{}
+}
+
+type assetMetadataResource struct {
+	client *sifflet.ClientWithResponses
+}
+
+// Metadata returns the resource type name.
+func (r *assetMetadataResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_metadata"
+}
+
+// Schema defines the schema for the resource.
+func (r *assetMetadataResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Catalog metadata of a Sifflet asset.",
+		MarkdownDescription: "Catalog metadata (description, owners, tags, business terms, custom metadata and column metadata) of an existing Sifflet asset, such as a table ingested from a data warehouse.\n\n" +
+			"This resource is authoritative for the attributes that are set in the configuration: for instance, when `tags` is set, tags added to the asset outside of Terraform are removed on the next apply. " +
+			"Attributes that are not set are not managed: the corresponding metadata is left untouched. When an attribute is removed from the configuration, or when the resource is destroyed, the corresponding metadata is cleared. " +
+			"Likewise, only the columns listed in `columns` are managed.\n\n" +
+			"An asset must be managed by at most one `sifflet_asset_metadata` resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the asset.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				Description: "URI identifying the asset. More about URIs here: https://docs.siffletdata.com/docs/uris. Updates require recreating the resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the asset.",
+				Optional:    true,
+			},
+			"owners":                 OwnersResourceAttribute("Owners of the asset."),
+			"tags":                   TagsResourceAttribute("Tags of the asset."),
+			"terms":                  TermsResourceAttribute("Business terms associated with the asset."),
+			"custom_metadata_values": CustomMetadataValuesResourceAttribute("Custom metadata values of the asset."),
+			"columns": schema.ListNestedAttribute{
+				Description: "Metadata of the columns (or fields) of the asset. Columns that are not listed are left untouched.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the column.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the column.",
+							Optional:    true,
+						},
+						"tags":  TagsResourceAttribute("Tags of the column."),
+						"terms": TermsResourceAttribute("Business terms associated with the column."),
+					},
+				},
+			},
+		},
+	}
+}
+
+// update sends the metadata changes between the prior state and the plan, and returns the new state.
+func (r *assetMetadataResource) update(ctx context.Context, plan assetMetadataModel, prior assetMetadataModel, summary string) (assetMetadataModel, diag.Diagnostics) {
+	body, diags := plan.toUpdateDto(ctx, prior)
+	if diags.HasError() {
+		return assetMetadataModel{}, diags
+	}
+
+	assetDto, diags := editAsset(ctx, r.client, body, summary)
+	if diags.HasError() {
+		return assetMetadataModel{}, diags
+	}
+
+	newState := plan
+	diags = newState.FromDto(ctx, assetDto)
+	if diags.HasError() {
+		return assetMetadataModel{}, diags
+	}
+	return newState, diag.Diagnostics{}
+}
+
+func (r *assetMetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := tfutils.WithDefaultCreateTimeout(ctx)
+	defer cancel()
+
+	var plan assetMetadataModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.update(ctx, plan, assetMetadataModel{}, "Unable to set asset metadata")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *assetMetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var state assetMetadataModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assetDto, found, diags := GetAsset(ctx, r.client, state.Uri.ValueString(), "Unable to read asset metadata")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = state.FromDto(ctx, assetDto)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *assetMetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := tfutils.WithDefaultUpdateTimeout(ctx)
+	defer cancel()
+
+	var plan assetMetadataModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state assetMetadataModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.update(ctx, plan, state, "Unable to update asset metadata")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *assetMetadataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := tfutils.WithDefaultDeleteTimeout(ctx)
+	defer cancel()
+
+	var state assetMetadataModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Clear all the metadata managed by the resource
+	_, diags = r.update(ctx, assetMetadataModel{Uri: state.Uri}, state, "Unable to clear asset metadata")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *assetMetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by URI. No metadata is managed right after the import, until the resource configuration is applied.
+	resource.ImportStatePassthroughID(ctx, path.Root("uri"), req, resp)
+}
+
+func (r *assetMetadataResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Client
+}
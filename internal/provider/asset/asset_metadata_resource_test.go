@@ -0,0 +1,90 @@
+package asset_test
+
+import (
+	"fmt"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAssetMetadataResource(t *testing.T) {
+	// The metadata is set on a declared asset, since we do not want to use real sources for tests.
+	ctx := t.Context()
+	client, err := providertests.ClientForTests(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	workspaceName := providertests.RandomName()
+	assetUri := providertests.RandomGithubDeclaredAssetUri()
+	subTypeName := "TerraformTest"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			asset := sifflet.PublicDeclarativeAssetDto{
+				Uri:     assetUri,
+				Type:    sifflet.Generic,
+				SubType: &subTypeName,
+			}
+			err := providertests.CreateDeclaredAssets(ctx, client, workspaceName, &[]sifflet.PublicDeclarativeAssetDto{asset})
+			if err != nil {
+				t.Fatalf("Failed to create declared assets: %v", err)
+			}
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_asset_metadata" "test" {
+						uri = "%s"
+						description = "Managed by Terraform provider tests"
+						tags = [{ name = "Non-Production" }]
+					}
+
+					data "sifflet_asset" "test" {
+						uri = sifflet_asset_metadata.test.uri
+						depends_on = [sifflet_asset_metadata.test]
+					}
+				`, assetUri),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sifflet_asset_metadata.test", "id"),
+					resource.TestCheckResourceAttr("sifflet_asset_metadata.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("sifflet_asset_metadata.test", "tags.0.name", "Non-Production"),
+					resource.TestCheckResourceAttr("data.sifflet_asset.test", "description", "Managed by Terraform provider tests"),
+					resource.TestCheckResourceAttr("data.sifflet_asset.test", "tags.#", "1"),
+				),
+			},
+			{
+				// Tags are no longer managed, they are cleared
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_asset_metadata" "test" {
+						uri = "%s"
+						description = "Updated by Terraform provider tests"
+					}
+
+					data "sifflet_asset" "test" {
+						uri = sifflet_asset_metadata.test.uri
+						depends_on = [sifflet_asset_metadata.test]
+					}
+				`, assetUri),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("sifflet_asset_metadata.test", "tags"),
+					resource.TestCheckResourceAttr("data.sifflet_asset.test", "description", "Updated by Terraform provider tests"),
+					resource.TestCheckResourceAttr("data.sifflet_asset.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:  "sifflet_asset_metadata.test",
+				ImportState:   true,
+				ImportStateId: assetUri,
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			return providertests.DeleteDeclaredAssets(ctx, client, workspaceName)
+		},
+	})
+}
@@ -0,0 +1,171 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &assetOwnerResource{}
+	_ resource.ResourceWithConfigure   = &assetOwnerResource{}
+	_ resource.ResourceWithImportState = &assetOwnerResource{}
+)
+
+func newAssetOwnerResource() resource.Resource {
+	return &assetOwnerResource{}
+}
+
+type assetOwnerResource struct {
+	client *sifflet.ClientWithResponses
+}
+
+// Metadata returns the resource type name.
+func (r *assetOwnerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_owner"
+}
+
+// Schema defines the schema for the resource.
+func (r *assetOwnerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "An owner attached to a Sifflet asset.",
+		MarkdownDescription: "An owner attached to a Sifflet asset. This resource is not authoritative: the other owners of the asset (added by other resources, or through the Sifflet UI) are left untouched. " +
+			"Destroying the resource only removes this owner from the asset. Creating the resource fails if the owner is already attached to the asset: import it instead.\n\n" +
+			"Don't use this resource together with a `sifflet_asset_metadata` resource managing the `owners` of the same asset.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the attachment, made of the asset ID and of the owner ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				Description: "URI identifying the asset. More about URIs here: https://docs.siffletdata.com/docs/uris. Updates require recreating the resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner": OwnerResourceAttribute("Owner (a user or a team) to attach to the asset. Updates require recreating the resource."),
+		},
+	}
+}
+
+func (r *assetOwnerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := tfutils.WithDefaultCreateTimeout(ctx)
+	defer cancel()
+
+	var plan assetOwnerModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := attachToAsset(ctx, r.client, plan, "owner")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Id = types.StringValue(id)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *assetOwnerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var state assetOwnerModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := isAttachedToAsset(ctx, r.client, state, "owner")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		// The asset was deleted, or the owner was removed from the asset outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+func (r *assetOwnerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so this is never called.
+	resp.Diagnostics.AddError(
+		"Unexpected update of an asset owner",
+		"Asset owners can't be updated in place. Please report this issue to the provider developers.",
+	)
+}
+
+func (r *assetOwnerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := tfutils.WithDefaultDeleteTimeout(ctx)
+	defer cancel()
+
+	var state assetOwnerModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = detachFromAsset(ctx, r.client, state, "owner")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *assetOwnerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by <asset ID>/<owner ID>. The imported owner is referenced by its ID.
+	uri, ownerId, diags := findAttachedAssetUri(ctx, r.client, req.ID, func(ownerId uuid.UUID) sifflet.PublicAssetFilterDto {
+		return sifflet.PublicAssetFilterDto{Owners: &[]sifflet.PublicReferenceByIdOrEmailDto{{Id: &ownerId}}}
+	}, "owner")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uri"), uri)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner").AtName("id"), ownerId.String())...)
+}
+
+func (r *assetOwnerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Client
+}
@@ -0,0 +1,112 @@
+package asset_test
+
+import (
+	"fmt"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAssetOwnerResource(t *testing.T) {
+	ctx := t.Context()
+	client, err := providertests.ClientForTests(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	workspaceName := providertests.RandomName()
+	assetUri := providertests.RandomGithubDeclaredAssetUri()
+	subTypeName := "TerraformTest"
+	teamName := providertests.RandomName()
+	userEmail := providertests.RandomEmail()
+
+	// All tenants have by default a domain named "All" with this static ID.
+	domainId := "aaaabbbb-aaaa-bbbb-aaaa-bbbbaaaabbbb"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			asset := sifflet.PublicDeclarativeAssetDto{
+				Uri:     assetUri,
+				Type:    sifflet.Generic,
+				SubType: &subTypeName,
+			}
+			err := providertests.CreateDeclaredAssets(ctx, client, workspaceName, &[]sifflet.PublicDeclarativeAssetDto{asset})
+			if err != nil {
+				t.Fatalf("Failed to create declared assets: %v", err)
+			}
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_team" "test" {
+						name = "%s"
+					}
+
+					resource "sifflet_user" "test" {
+						email = "%s"
+						name = "Terraform Test User"
+						role = "VIEWER"
+						permissions = [{
+							domain_id = "%s"
+							domain_role = "VIEWER"
+						}]
+					}
+
+					resource "sifflet_asset_owner" "team" {
+						uri = "%s"
+						owner = { id = sifflet_team.test.id }
+					}
+
+					resource "sifflet_asset_owner" "user" {
+						uri = "%s"
+						owner = { email = sifflet_user.test.email }
+						depends_on = [sifflet_asset_owner.team]
+					}
+				`, teamName, userEmail, domainId, assetUri, assetUri),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sifflet_asset_owner.team", "id"),
+					resource.TestCheckResourceAttrSet("sifflet_asset_owner.user", "id"),
+				),
+			},
+			{
+				ResourceName:      "sifflet_asset_owner.team",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing one owner leaves the other one untouched
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_team" "test" {
+						name = "%s"
+					}
+
+					resource "sifflet_user" "test" {
+						email = "%s"
+						name = "Terraform Test User"
+						role = "VIEWER"
+						permissions = [{
+							domain_id = "%s"
+							domain_role = "VIEWER"
+						}]
+					}
+
+					resource "sifflet_asset_owner" "team" {
+						uri = "%s"
+						owner = { id = sifflet_team.test.id }
+					}
+				`, teamName, userEmail, domainId, assetUri),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sifflet_asset_owner.team", "id"),
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			return providertests.DeleteDeclaredAssets(ctx, client, workspaceName)
+		},
+	})
+}
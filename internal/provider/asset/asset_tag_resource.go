@@ -0,0 +1,171 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &assetTagResource{}
+	_ resource.ResourceWithConfigure   = &assetTagResource{}
+	_ resource.ResourceWithImportState = &assetTagResource{}
+)
+
+func newAssetTagResource() resource.Resource {
+	return &assetTagResource{}
+}
+
+type assetTagResource struct {
+	client *sifflet.ClientWithResponses
+}
+
+// Metadata returns the resource type name.
+func (r *assetTagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_tag"
+}
+
+// Schema defines the schema for the resource.
+func (r *assetTagResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A tag attached to a Sifflet asset.",
+		MarkdownDescription: "A tag attached to a Sifflet asset. This resource is not authoritative: the other tags of the asset (added by other resources, or through the Sifflet UI) are left untouched. " +
+			"Destroying the resource only removes this tag from the asset. Creating the resource fails if the tag is already attached to the asset: import it instead.\n\n" +
+			"Don't use this resource together with a `sifflet_asset_metadata` resource managing the `tags` of the same asset.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the attachment, made of the asset ID and of the tag ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uri": schema.StringAttribute{
+				Description: "URI identifying the asset. More about URIs here: https://docs.siffletdata.com/docs/uris. Updates require recreating the resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag": TagResourceAttribute("Tag to attach to the asset. Updates require recreating the resource."),
+		},
+	}
+}
+
+func (r *assetTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := tfutils.WithDefaultCreateTimeout(ctx)
+	defer cancel()
+
+	var plan assetTagModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := attachToAsset(ctx, r.client, plan, "tag")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Id = types.StringValue(id)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *assetTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var state assetTagModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := isAttachedToAsset(ctx, r.client, state, "tag")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		// The asset was deleted, or the tag was removed from the asset outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+}
+
+func (r *assetTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so this is never called.
+	resp.Diagnostics.AddError(
+		"Unexpected update of an asset tag",
+		"Asset tags can't be updated in place. Please report this issue to the provider developers.",
+	)
+}
+
+func (r *assetTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := tfutils.WithDefaultDeleteTimeout(ctx)
+	defer cancel()
+
+	var state assetTagModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = detachFromAsset(ctx, r.client, state, "tag")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *assetTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by <asset ID>/<tag ID>. The imported tag is referenced by its ID.
+	uri, tagId, diags := findAttachedAssetUri(ctx, r.client, req.ID, func(tagId uuid.UUID) sifflet.PublicAssetFilterDto {
+		return sifflet.PublicAssetFilterDto{Tags: &[]sifflet.PublicTagReferenceDto{{Id: &tagId}}}
+	}, "tag")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uri"), uri)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag").AtName("id"), tagId.String())...)
+}
+
+func (r *assetTagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Client
+}
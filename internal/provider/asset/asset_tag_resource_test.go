@@ -0,0 +1,138 @@
+package asset_test
+
+import (
+	"fmt"
+	"regexp"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAssetTagResource(t *testing.T) {
+	ctx := t.Context()
+	client, err := providertests.ClientForTests(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	workspaceName := providertests.RandomName()
+	assetUri := providertests.RandomGithubDeclaredAssetUri()
+	subTypeName := "TerraformTest"
+	tagName := providertests.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			asset := sifflet.PublicDeclarativeAssetDto{
+				Uri:     assetUri,
+				Type:    sifflet.Generic,
+				SubType: &subTypeName,
+			}
+			err := providertests.CreateDeclaredAssets(ctx, client, workspaceName, &[]sifflet.PublicDeclarativeAssetDto{asset})
+			if err != nil {
+				t.Fatalf("Failed to create declared assets: %v", err)
+			}
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_tag" "test" {
+						name = "%s"
+					}
+
+					resource "sifflet_asset_tag" "by_id" {
+						uri = "%s"
+						tag = { id = sifflet_tag.test.id }
+					}
+
+					resource "sifflet_asset_tag" "by_name" {
+						uri = "%s"
+						tag = { name = "Non-Production" }
+						depends_on = [sifflet_asset_tag.by_id]
+					}
+				`, tagName, assetUri, assetUri),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sifflet_asset_tag.by_id", "id"),
+					resource.TestCheckResourceAttrSet("sifflet_asset_tag.by_name", "id"),
+				),
+			},
+			{
+				ResourceName:      "sifflet_asset_tag.by_id",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing one tag leaves the other one untouched
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_tag" "test" {
+						name = "%s"
+					}
+
+					resource "sifflet_asset_tag" "by_id" {
+						uri = "%s"
+						tag = { id = sifflet_tag.test.id }
+					}
+
+					data "sifflet_asset" "test" {
+						uri = sifflet_asset_tag.by_id.uri
+					}
+				`, tagName, assetUri),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sifflet_asset.test", "tags.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sifflet_asset.test", "tags.0.id", "sifflet_tag.test", "id"),
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			return providertests.DeleteDeclaredAssets(ctx, client, workspaceName)
+		},
+	})
+}
+
+func TestAccAssetTagResourceAlreadyAttached(t *testing.T) {
+	ctx := t.Context()
+	client, err := providertests.ClientForTests(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	workspaceName := providertests.RandomName()
+	assetUri := providertests.RandomGithubDeclaredAssetUri()
+	subTypeName := "TerraformTest"
+	tagName := "Non-Production"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			asset := sifflet.PublicDeclarativeAssetDto{
+				Uri:     assetUri,
+				Type:    sifflet.Generic,
+				SubType: &subTypeName,
+				Tags:    &[]sifflet.PublicTagReferenceDto{{Name: &tagName}},
+			}
+			err := providertests.CreateDeclaredAssets(ctx, client, workspaceName, &[]sifflet.PublicDeclarativeAssetDto{asset})
+			if err != nil {
+				t.Fatalf("Failed to create declared assets: %v", err)
+			}
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// A tag attached outside of the resource is not adopted, since destroying the resource would remove it
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_asset_tag" "test" {
+						uri = "%s"
+						tag = { name = "%s" }
+					}
+				`, assetUri, tagName),
+				ExpectError: regexp.MustCompile("The tag is already attached to asset"),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			return providertests.DeleteDeclaredAssets(ctx, client, workspaceName)
+		},
+	})
+}
@@ -104,49 +104,54 @@ func AssetsDataSourceSchema(ctx context.Context) schema.Schema {
 					},
 				},
 			},
-			"results": schema.ListNestedAttribute{
-				Description: "List of assets returned by the search.",
-				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							Description: "Asset ID.",
-							Computed:    true,
-						},
-						"name": schema.StringAttribute{
-							Description: "Asset name.",
-							Computed:    true,
-						},
-						"type": schema.StringAttribute{
-							Description: "Asset type. This is the specific type of the asset, not the broader type category used in the filter. For example, an asset in type category TABLE_AND_VIEW can have the type TABLE.",
-							Computed:    true,
-						},
-						"uri": schema.StringAttribute{
-							Description: "URI string identifying the asset. More about URIs here: https://docs.siffletdata.com/docs/uris.",
-							Computed:    true,
-						},
-						"description": schema.StringAttribute{
-							Description: "Asset description.",
-							Computed:    true,
-						},
-						"tags": schema.ListNestedAttribute{
-							Description: "List of tags associated with this source.",
-							Computed:    true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"id": schema.StringAttribute{
-										Description: "Tag ID.",
-										Computed:    true,
-									},
-									"name": schema.StringAttribute{
-										Description: "Tag name.",
-										Computed:    true,
-									},
-									"kind": schema.StringAttribute{
-										Description: "Tag kind (such as 'Tag' or 'Classification').",
-										Computed:    true,
-									},
-								},
+			"results": assetsResultsAttribute("List of assets returned by the search."),
+		},
+	}
+}
+
+// assetsResultsAttribute returns the schema of the list of assets returned by the data sources searching assets.
+func assetsResultsAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "Asset ID.",
+					Computed:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "Asset name.",
+					Computed:    true,
+				},
+				"type": schema.StringAttribute{
+					Description: "Asset type. This is the specific type of the asset, not the broader type category used in the filter. For example, an asset in type category TABLE_AND_VIEW can have the type TABLE.",
+					Computed:    true,
+				},
+				"uri": schema.StringAttribute{
+					Description: "URI string identifying the asset. More about URIs here: https://docs.siffletdata.com/docs/uris.",
+					Computed:    true,
+				},
+				"description": schema.StringAttribute{
+					Description: "Asset description.",
+					Computed:    true,
+				},
+				"tags": schema.ListNestedAttribute{
+					Description: "List of tags associated with this source.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								Description: "Tag ID.",
+								Computed:    true,
+							},
+							"name": schema.StringAttribute{
+								Description: "Tag name.",
+								Computed:    true,
+							},
+							"kind": schema.StringAttribute{
+								Description: "Tag kind (such as 'Tag' or 'Classification').",
+								Computed:    true,
 							},
 						},
 					},
@@ -0,0 +1,169 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &domainAssetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &domainAssetsDataSource{}
+)
+
+func newDomainAssetsDataSource() datasource.DataSource {
+	return &domainAssetsDataSource{}
+}
+
+type domainAssetsDataSource struct {
+	client *sifflet.ClientWithResponses
+}
+
+func (d *domainAssetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Client
+}
+
+func (d *domainAssetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_assets"
+}
+
+func (d *domainAssetsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Return the assets contained in a domain.",
+		MarkdownDescription: "Return the assets contained in a domain. For a domain with a dynamic content definition, these are the assets currently matching its conditions.\n\n" +
+			"Use `total_count` to check that a domain isn't empty, for instance in a `check` block: it counts all the assets of the domain, even when there are more than `max_results`.",
+		Attributes: map[string]schema.Attribute{
+			"domain_id": schema.StringAttribute{
+				Description: "The ID of the domain.",
+				Required:    true,
+			},
+			"max_results": schema.Int32Attribute{
+				Description: "Maximum number of results to return. Default is 1000.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"total_count": schema.Int64Attribute{
+				Description: "Total number of assets contained in the domain.",
+				Computed:    true,
+			},
+			"results": assetsResultsAttribute("List of assets contained in the domain."),
+		},
+	}
+}
+
+type domainAssetsDataSourceModel struct {
+	DomainId   types.String `tfsdk:"domain_id"`
+	MaxResults types.Int32  `tfsdk:"max_results"`
+	TotalCount types.Int64  `tfsdk:"total_count"`
+	Results    types.List   `tfsdk:"results"`
+}
+
+func (d *domainAssetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var data domainAssetsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainId, err := uuid.Parse(data.DomainId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Could not parse domain ID as UUID", err.Error())
+		return
+	}
+
+	maxResults := data.MaxResults.ValueInt32()
+	if data.MaxResults.IsNull() {
+		maxResults = 1000
+	}
+
+	filterDto := sifflet.PublicAssetFilterDto{
+		DomainId: &domainId,
+	}
+	results := make([]assetModel, 0)
+	var totalCount int64
+	var itemsPerPage int32 = 100
+	for page := int32(0); int32(len(results)) < maxResults; page++ { // nolint: gosec
+		paginationDto := sifflet.PublicAssetPaginationDto{
+			ItemsPerPage: &itemsPerPage,
+			Page:         &page,
+		}
+		searchResponse, err := d.client.PublicGetAssetsWithResponse(ctx, sifflet.PublicAssetSearchCriteriaDto{
+			Filter:     &filterDto,
+			Pagination: &paginationDto,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read domain assets", err.Error())
+			return
+		}
+		if searchResponse.StatusCode() != http.StatusOK {
+			sifflet.HandleHttpErrorAsProblem(
+				ctx, &resp.Diagnostics, "Unable to read domain assets", searchResponse.StatusCode(), searchResponse.Body,
+			)
+			return
+		}
+
+		responseDto := *searchResponse.JSON200
+		if responseDto.TotalCount != nil {
+			totalCount = *responseDto.TotalCount
+		}
+		for _, assetDto := range responseDto.Data {
+			if int32(len(results)) >= maxResults { // nolint: gosec
+				break
+			}
+			var asset assetModel
+			resp.Diagnostics.Append(asset.FromListDto(ctx, assetDto)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			results = append(results, asset)
+		}
+		if int32(len(responseDto.Data)) < itemsPerPage { // nolint: gosec
+			// Last page
+			break
+		}
+	}
+	if totalCount < int64(len(results)) {
+		// The API didn't return the total count
+		totalCount = int64(len(results))
+	}
+
+	data.TotalCount = types.Int64Value(totalCount)
+	resultsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: assetModel{}.AttributeTypes()}, results)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Results = resultsList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
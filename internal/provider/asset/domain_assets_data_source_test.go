@@ -0,0 +1,85 @@
+package asset_test
+
+import (
+	"fmt"
+	"regexp"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDomainAssetsDataSource(t *testing.T) {
+	ctx := t.Context()
+	client, err := providertests.ClientForTests(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	workspaceName := providertests.RandomName()
+	subTypeName := "TerraformTest"
+	assetUri := providertests.RandomGithubDeclaredAssetUri()
+	assetName := providertests.SessionPrefix() + " " + assetUri
+	domainName := providertests.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			// Create the declared assets
+			asset := sifflet.PublicDeclarativeAssetDto{
+				Uri:     assetUri,
+				Name:    &assetName,
+				Type:    sifflet.Generic,
+				SubType: &subTypeName,
+			}
+			err := providertests.CreateDeclaredAssets(ctx, client, workspaceName, &[]sifflet.PublicDeclarativeAssetDto{asset})
+			if err != nil {
+				t.Fatalf("Failed to create declared assets: %v", err)
+			}
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+				resource "sifflet_domain" "test" {
+					name = "%s"
+					static_content_definition = {
+						asset_uris = ["%s"]
+					}
+				}
+
+				data "sifflet_domain_assets" "test" {
+					domain_id = sifflet_domain.test.id
+				}`, domainName, assetUri),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sifflet_domain_assets.test", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.sifflet_domain_assets.test", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.sifflet_domain_assets.test", "results.0.name", assetName),
+					resource.TestCheckResourceAttr("data.sifflet_domain_assets.test", "results.0.uri", assetUri),
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			// Delete the declared assets and all related resources
+			err := providertests.DeleteDeclaredAssets(ctx, client, workspaceName)
+			return err
+		},
+	})
+}
+
+func TestAccDomainAssetsReadErrorDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_domain_assets" "test" {
+					domain_id = "not-a-uuid"
+				}`,
+				ExpectError: regexp.MustCompile("Could not parse domain ID as UUID"),
+			},
+		},
+	})
+}
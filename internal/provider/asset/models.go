@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 type assetModel struct {
@@ -84,3 +85,523 @@ func (m assetModel) ModelId() (uuid.UUID, diag.Diagnostics) {
 	}
 	return id, diag.Diagnostics{}
 }
+
+// assetMetadataModel is the state of a sifflet_asset_metadata resource. Attributes that are null are not managed by
+// the resource: they are not sent to the API, and they are not read back.
+type assetMetadataModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Uri                  types.String `tfsdk:"uri"`
+	Description          types.String `tfsdk:"description"`
+	Owners               types.List   `tfsdk:"owners"`
+	Tags                 types.List   `tfsdk:"tags"`
+	Terms                types.List   `tfsdk:"terms"`
+	CustomMetadataValues types.List   `tfsdk:"custom_metadata_values"`
+	Columns              types.List   `tfsdk:"columns"`
+}
+
+var (
+	_ model.ReadableModel[sifflet.PublicGetAssetDto]     = &assetMetadataModel{}
+	_ model.UpdatableModel[sifflet.PublicUpdateAssetDto] = &assetMetadataModel{}
+)
+
+type assetColumnMetadataModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.List   `tfsdk:"tags"`
+	Terms       types.List   `tfsdk:"terms"`
+}
+
+func (m assetColumnMetadataModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"description": types.StringType,
+		"tags": types.ListType{
+			ElemType: types.ObjectType{AttrTypes: tag.PublicApiTagModel{}.AttributeTypes()},
+		},
+		"terms": types.ListType{
+			ElemType: types.ObjectType{AttrTypes: PublicApiTermModel{}.AttributeTypes()},
+		},
+	}
+}
+
+// descriptionToDto returns the description to send to the API. A description that was previously managed but is now
+// null is cleared.
+func descriptionToDto(description types.String, prior types.String) *string {
+	if description.IsNull() {
+		if prior.IsNull() {
+			return nil
+		}
+		empty := ""
+		return &empty
+	}
+	return description.ValueStringPointer()
+}
+
+// referencesToDto converts a list of references to the DTO expected by the API. A list that was previously managed
+// but is now null is cleared, a list that is not managed is not sent.
+func referencesToDto[M interface {
+	ToDto(context.Context) (D, diag.Diagnostics)
+}, D any](ctx context.Context, references types.List, prior types.List) (*[]D, diag.Diagnostics) {
+	if references.IsNull() {
+		if prior.IsNull() {
+			return nil, diag.Diagnostics{}
+		}
+		return &[]D{}, diag.Diagnostics{}
+	}
+	models := make([]M, 0, len(references.Elements()))
+	diags := references.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	dtos, diags := tfutils.MapWithDiagnostics(models, func(m M) (D, diag.Diagnostics) {
+		return m.ToDto(ctx)
+	})
+	return &dtos, diags
+}
+
+// reconcileReferencesList reads back a list of references from the API, keeping the configured form of the references.
+// See reconcileReferences.
+func reconcileReferencesList[M any, D any](
+	ctx context.Context, configured types.List, dtos *[]D, attributeTypes map[string]attr.Type,
+	fromDto func(D) (M, diag.Diagnostics), matches func(configured M, actual M) bool,
+) (types.List, diag.Diagnostics) {
+	configuredModels := make([]M, 0, len(configured.Elements()))
+	diags := configured.ElementsAs(ctx, &configuredModels, false)
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: attributeTypes}), diags
+	}
+	actualDtos := []D{}
+	if dtos != nil {
+		actualDtos = *dtos
+	}
+	actualModels, diags := tfutils.MapWithDiagnostics(actualDtos, fromDto)
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: attributeTypes}), diags
+	}
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: attributeTypes}, reconcileReferences(configuredModels, actualModels, matches))
+}
+
+func reconcileTags(ctx context.Context, configured types.List, dtos *[]sifflet.PublicTagReferenceDto) (types.List, diag.Diagnostics) {
+	return reconcileReferencesList(ctx, configured, dtos, tag.PublicApiTagModel{}.AttributeTypes(),
+		func(dto sifflet.PublicTagReferenceDto) (tag.PublicApiTagModel, diag.Diagnostics) {
+			var m tag.PublicApiTagModel
+			diags := m.FromDto(ctx, dto)
+			return m, diags
+		},
+		tagMatches,
+	)
+}
+
+func reconcileOwners(ctx context.Context, configured types.List, dtos *[]sifflet.PublicReferenceByIdOrEmailDto) (types.List, diag.Diagnostics) {
+	return reconcileReferencesList(ctx, configured, dtos, PublicApiOwnerModel{}.AttributeTypes(),
+		func(dto sifflet.PublicReferenceByIdOrEmailDto) (PublicApiOwnerModel, diag.Diagnostics) {
+			var m PublicApiOwnerModel
+			diags := m.FromDto(ctx, dto)
+			return m, diags
+		},
+		ownerMatches,
+	)
+}
+
+func reconcileTerms(ctx context.Context, configured types.List, dtos *[]sifflet.PublicReferenceByIdOrNameDto) (types.List, diag.Diagnostics) {
+	return reconcileReferencesList(ctx, configured, dtos, PublicApiTermModel{}.AttributeTypes(),
+		func(dto sifflet.PublicReferenceByIdOrNameDto) (PublicApiTermModel, diag.Diagnostics) {
+			var m PublicApiTermModel
+			diags := m.FromDto(ctx, dto)
+			return m, diags
+		},
+		termMatches,
+	)
+}
+
+func (m assetColumnMetadataModel) toDto(ctx context.Context, prior assetColumnMetadataModel) (sifflet.PublicUpdateAssetColumnDto, diag.Diagnostics) {
+	tags, diags := referencesToDto[tag.PublicApiTagModel, sifflet.PublicTagReferenceDto](ctx, m.Tags, prior.Tags)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetColumnDto{}, diags
+	}
+	terms, diags := referencesToDto[PublicApiTermModel, sifflet.PublicReferenceByIdOrNameDto](ctx, m.Terms, prior.Terms)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetColumnDto{}, diags
+	}
+	return sifflet.PublicUpdateAssetColumnDto{
+		Name:        m.Name.ValueString(),
+		Description: descriptionToDto(m.Description, prior.Description),
+		Tags:        tags,
+		Terms:       terms,
+	}, diag.Diagnostics{}
+}
+
+func (m assetMetadataModel) getColumns(ctx context.Context) ([]assetColumnMetadataModel, diag.Diagnostics) {
+	columns := make([]assetColumnMetadataModel, 0, len(m.Columns.Elements()))
+	diags := m.Columns.ElementsAs(ctx, &columns, false)
+	return columns, diags
+}
+
+func (m assetMetadataModel) getColumnsDto(ctx context.Context, prior assetMetadataModel) (*[]sifflet.PublicUpdateAssetColumnDto, diag.Diagnostics) {
+	columns, diags := m.getColumns(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	priorColumns, diags := prior.getColumns(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if len(columns) == 0 && len(priorColumns) == 0 {
+		return nil, diag.Diagnostics{}
+	}
+
+	priorColumnsByName := make(map[string]assetColumnMetadataModel, len(priorColumns))
+	for _, column := range priorColumns {
+		priorColumnsByName[column.Name.ValueString()] = column
+	}
+
+	dtos := make([]sifflet.PublicUpdateAssetColumnDto, 0, len(columns)+len(priorColumns))
+	for _, column := range columns {
+		dto, diags := column.toDto(ctx, priorColumnsByName[column.Name.ValueString()])
+		if diags.HasError() {
+			return nil, diags
+		}
+		dtos = append(dtos, dto)
+		delete(priorColumnsByName, column.Name.ValueString())
+	}
+	// Clear the metadata of the columns that are no longer managed
+	for _, priorColumn := range priorColumns {
+		if _, ok := priorColumnsByName[priorColumn.Name.ValueString()]; !ok {
+			continue
+		}
+		dto, diags := assetColumnMetadataModel{Name: priorColumn.Name}.toDto(ctx, priorColumn)
+		if diags.HasError() {
+			return nil, diags
+		}
+		dtos = append(dtos, dto)
+	}
+	return &dtos, diag.Diagnostics{}
+}
+
+func (m assetMetadataModel) getCustomMetadataValuesDto(ctx context.Context, prior assetMetadataModel) (*[]sifflet.PublicUpdateAssetDto_CustomMetadataValues_Item, diag.Diagnostics) {
+	if m.CustomMetadataValues.IsNull() {
+		if prior.CustomMetadataValues.IsNull() {
+			return nil, diag.Diagnostics{}
+		}
+		return &[]sifflet.PublicUpdateAssetDto_CustomMetadataValues_Item{}, diag.Diagnostics{}
+	}
+	values := make([]PublicApiCustomMetadataValueModel, 0, len(m.CustomMetadataValues.Elements()))
+	diags := m.CustomMetadataValues.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	dtos, diags := tfutils.MapWithDiagnostics(values, func(value PublicApiCustomMetadataValueModel) (sifflet.PublicUpdateAssetDto_CustomMetadataValues_Item, diag.Diagnostics) {
+		var item sifflet.PublicUpdateAssetDto_CustomMetadataValues_Item
+		diags := value.WriteDto(&item)
+		return item, diags
+	})
+	return &dtos, diags
+}
+
+// toUpdateDto returns the DTO updating the asset metadata from the prior state to this model. Metadata that was
+// managed in the prior state but that is no longer managed is cleared.
+func (m assetMetadataModel) toUpdateDto(ctx context.Context, prior assetMetadataModel) (sifflet.PublicUpdateAssetDto, diag.Diagnostics) {
+	owners, diags := referencesToDto[PublicApiOwnerModel, sifflet.PublicReferenceByIdOrEmailDto](ctx, m.Owners, prior.Owners)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetDto{}, diags
+	}
+	tags, diags := referencesToDto[tag.PublicApiTagModel, sifflet.PublicTagReferenceDto](ctx, m.Tags, prior.Tags)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetDto{}, diags
+	}
+	terms, diags := referencesToDto[PublicApiTermModel, sifflet.PublicReferenceByIdOrNameDto](ctx, m.Terms, prior.Terms)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetDto{}, diags
+	}
+	customMetadataValues, diags := m.getCustomMetadataValuesDto(ctx, prior)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetDto{}, diags
+	}
+	columns, diags := m.getColumnsDto(ctx, prior)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetDto{}, diags
+	}
+
+	return sifflet.PublicUpdateAssetDto{
+		Uri:                  m.Uri.ValueString(),
+		Description:          descriptionToDto(m.Description, prior.Description),
+		Owners:               owners,
+		Tags:                 tags,
+		Terms:                terms,
+		CustomMetadataValues: customMetadataValues,
+		Columns:              columns,
+	}, diag.Diagnostics{}
+}
+
+func (m assetMetadataModel) ToUpdateDto(ctx context.Context) (sifflet.PublicUpdateAssetDto, diag.Diagnostics) {
+	return m.toUpdateDto(ctx, assetMetadataModel{})
+}
+
+// FromDto reads back the managed metadata of the asset.
+func (m *assetMetadataModel) FromDto(ctx context.Context, dto sifflet.PublicGetAssetDto) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Id = types.StringValue(dto.Id.String())
+	m.Uri = types.StringValue(dto.Uri)
+	if !m.Description.IsNull() {
+		m.Description = types.StringValue(valueOrEmpty(dto.Description))
+	}
+	if !m.Owners.IsNull() {
+		m.Owners, diags = reconcileOwners(ctx, m.Owners, dto.Owners)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	if !m.Tags.IsNull() {
+		m.Tags, diags = reconcileTags(ctx, m.Tags, dto.Tags)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	if !m.Terms.IsNull() {
+		m.Terms, diags = reconcileTerms(ctx, m.Terms, dto.Terms)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	if !m.CustomMetadataValues.IsNull() {
+		m.CustomMetadataValues, diags = reconcileReferencesList(ctx, m.CustomMetadataValues, dto.CustomMetadataValues, PublicApiCustomMetadataValueModel{}.AttributeTypes(),
+			func(item sifflet.PublicGetAssetDto_CustomMetadataValues_Item) (PublicApiCustomMetadataValueModel, diag.Diagnostics) {
+				var value PublicApiCustomMetadataValueModel
+				diags := value.FromDto(item)
+				return value, diags
+			},
+			customMetadataValueMatches,
+		)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	if !m.Columns.IsNull() {
+		m.Columns, diags = m.readColumns(ctx, dto)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	return diag.Diagnostics{}
+}
+
+// readColumns reads back the metadata of the managed columns. Columns that no longer exist are removed.
+func (m assetMetadataModel) readColumns(ctx context.Context, dto sifflet.PublicGetAssetDto) (types.List, diag.Diagnostics) {
+	columnsType := types.ObjectType{AttrTypes: assetColumnMetadataModel{}.AttributeTypes()}
+
+	columns, diags := m.getColumns(ctx)
+	if diags.HasError() {
+		return types.ListNull(columnsType), diags
+	}
+
+	columnDtosByName := make(map[string]sifflet.PublicGetAssetColumnDto)
+	if dto.Columns != nil {
+		for _, columnDto := range *dto.Columns {
+			columnDtosByName[columnDto.Name] = columnDto
+		}
+	}
+
+	newColumns := make([]assetColumnMetadataModel, 0, len(columns))
+	for _, column := range columns {
+		columnDto, ok := columnDtosByName[column.Name.ValueString()]
+		if !ok {
+			continue
+		}
+		if !column.Description.IsNull() {
+			column.Description = types.StringValue(valueOrEmpty(columnDto.Description))
+		}
+		if !column.Tags.IsNull() {
+			column.Tags, diags = reconcileTags(ctx, column.Tags, columnDto.Tags)
+			if diags.HasError() {
+				return types.ListNull(columnsType), diags
+			}
+		}
+		if !column.Terms.IsNull() {
+			column.Terms, diags = reconcileTerms(ctx, column.Terms, columnDto.Terms)
+			if diags.HasError() {
+				return types.ListNull(columnsType), diags
+			}
+		}
+		newColumns = append(newColumns, column)
+	}
+	return types.ListValueFrom(ctx, columnsType, newColumns)
+}
+
+// assetTagModel is the state of a sifflet_asset_tag resource: a single tag attached to an asset.
+type assetTagModel struct {
+	Id  types.String `tfsdk:"id"`
+	Uri types.String `tfsdk:"uri"`
+	Tag types.Object `tfsdk:"tag"`
+}
+
+var _ assetAttachmentModel = assetTagModel{}
+
+func (m assetTagModel) getUri() string {
+	return m.Uri.ValueString()
+}
+
+func (m assetTagModel) getTag(ctx context.Context) (tag.PublicApiTagModel, diag.Diagnostics) {
+	var tagModel tag.PublicApiTagModel
+	diags := m.Tag.As(ctx, &tagModel, basetypes.ObjectAsOptions{})
+	return tagModel, diags
+}
+
+func (m assetTagModel) findAttached(ctx context.Context, dto sifflet.PublicGetAssetDto) (id string, found bool, diags diag.Diagnostics) {
+	configured, diags := m.getTag(ctx)
+	if diags.HasError() || dto.Tags == nil {
+		return "", false, diags
+	}
+	for _, tagDto := range *dto.Tags {
+		if tagDto.Id == nil {
+			continue
+		}
+		var actual tag.PublicApiTagModel
+		diags = actual.FromDto(ctx, tagDto)
+		if diags.HasError() {
+			return "", false, diags
+		}
+		if tagMatches(configured, actual) {
+			return actual.ID.ValueString(), true, diags
+		}
+	}
+	return "", false, diags
+}
+
+func (m assetTagModel) attachDto(ctx context.Context, dto sifflet.PublicGetAssetDto) (sifflet.PublicUpdateAssetDto, diag.Diagnostics) {
+	configured, diags := m.getTag(ctx)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetDto{}, diags
+	}
+	tagDto, diags := configured.ToDto(ctx)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetDto{}, diags
+	}
+
+	tags, diags := tagReferences(dto.Tags)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetDto{}, diags
+	}
+	tags = append(tags, tagDto)
+	return sifflet.PublicUpdateAssetDto{Uri: m.Uri.ValueString(), Tags: &tags}, diags
+}
+
+func (m assetTagModel) detachDto(dto sifflet.PublicGetAssetDto, id string) (sifflet.PublicUpdateAssetDto, diag.Diagnostics) {
+	references, diags := tagReferences(dto.Tags)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetDto{}, diags
+	}
+	tags := make([]sifflet.PublicTagReferenceDto, 0)
+	for _, tagDto := range references {
+		if tagDto.Id == nil || tagDto.Id.String() != id {
+			tags = append(tags, tagDto)
+		}
+	}
+	return sifflet.PublicUpdateAssetDto{Uri: m.Uri.ValueString(), Tags: &tags}, diags
+}
+
+// tagReferences converts the tags returned by the API to references by ID (or by name and kind when the ID is missing),
+// to send them back to the API. It fails if a tag has neither an ID nor a name, since the tag would be removed from the asset.
+func tagReferences(dtos *[]sifflet.PublicTagReferenceDto) ([]sifflet.PublicTagReferenceDto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	references := make([]sifflet.PublicTagReferenceDto, 0)
+	if dtos == nil {
+		return references, diags
+	}
+	for _, dto := range *dtos {
+		switch {
+		case dto.Id != nil:
+			references = append(references, sifflet.PublicTagReferenceDto{Id: dto.Id})
+		case dto.Name != nil:
+			references = append(references, sifflet.PublicTagReferenceDto{Name: dto.Name, Kind: dto.Kind})
+		default:
+			diags.AddError(
+				"Unable to update asset tags",
+				"The API returned a tag of the asset without an ID or a name. Updating the tags of the asset would remove this tag.",
+			)
+			return nil, diags
+		}
+	}
+	return references, diags
+}
+
+// assetOwnerModel is the state of a sifflet_asset_owner resource: a single owner attached to an asset.
+type assetOwnerModel struct {
+	Id    types.String `tfsdk:"id"`
+	Uri   types.String `tfsdk:"uri"`
+	Owner types.Object `tfsdk:"owner"`
+}
+
+var _ assetAttachmentModel = assetOwnerModel{}
+
+func (m assetOwnerModel) getUri() string {
+	return m.Uri.ValueString()
+}
+
+func (m assetOwnerModel) getOwner(ctx context.Context) (PublicApiOwnerModel, diag.Diagnostics) {
+	var ownerModel PublicApiOwnerModel
+	diags := m.Owner.As(ctx, &ownerModel, basetypes.ObjectAsOptions{})
+	return ownerModel, diags
+}
+
+func (m assetOwnerModel) findAttached(ctx context.Context, dto sifflet.PublicGetAssetDto) (id string, found bool, diags diag.Diagnostics) {
+	configured, diags := m.getOwner(ctx)
+	if diags.HasError() || dto.Owners == nil {
+		return "", false, diags
+	}
+	for _, ownerDto := range *dto.Owners {
+		if ownerDto.Id == nil {
+			continue
+		}
+		var actual PublicApiOwnerModel
+		diags = actual.FromDto(ctx, ownerDto)
+		if diags.HasError() {
+			return "", false, diags
+		}
+		if ownerMatches(configured, actual) {
+			return actual.ID.ValueString(), true, diags
+		}
+	}
+	return "", false, diags
+}
+
+func (m assetOwnerModel) attachDto(ctx context.Context, dto sifflet.PublicGetAssetDto) (sifflet.PublicUpdateAssetDto, diag.Diagnostics) {
+	configured, diags := m.getOwner(ctx)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetDto{}, diags
+	}
+	ownerDto, diags := configured.ToDto(ctx)
+	if diags.HasError() {
+		return sifflet.PublicUpdateAssetDto{}, diags
+	}
+
+	owners := append(ownerReferences(dto.Owners), ownerDto)
+	return sifflet.PublicUpdateAssetDto{Uri: m.Uri.ValueString(), Owners: &owners}, diags
+}
+
+func (m assetOwnerModel) detachDto(dto sifflet.PublicGetAssetDto, id string) (sifflet.PublicUpdateAssetDto, diag.Diagnostics) {
+	owners := make([]sifflet.PublicReferenceByIdOrEmailDto, 0)
+	for _, ownerDto := range ownerReferences(dto.Owners) {
+		if ownerDto.Id == nil || ownerDto.Id.String() != id {
+			owners = append(owners, ownerDto)
+		}
+	}
+	return sifflet.PublicUpdateAssetDto{Uri: m.Uri.ValueString(), Owners: &owners}, diag.Diagnostics{}
+}
+
+// ownerReferences converts the owners returned by the API to references by ID (or by email when the ID is missing),
+// to send them back to the API.
+func ownerReferences(dtos *[]sifflet.PublicReferenceByIdOrEmailDto) []sifflet.PublicReferenceByIdOrEmailDto {
+	references := make([]sifflet.PublicReferenceByIdOrEmailDto, 0)
+	if dtos == nil {
+		return references
+	}
+	for _, dto := range *dtos {
+		if dto.Id != nil {
+			references = append(references, sifflet.PublicReferenceByIdOrEmailDto{Id: dto.Id})
+		} else {
+			references = append(references, sifflet.PublicReferenceByIdOrEmailDto{Email: dto.Email})
+		}
+	}
+	return references
+}
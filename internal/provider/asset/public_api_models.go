@@ -0,0 +1,280 @@
+package asset
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/model"
+	"terraform-provider-sifflet/internal/provider/tag"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PublicApiOwnerModel is a reference to an asset owner (a user or a team), by ID or by email.
+type PublicApiOwnerModel struct {
+	ID    types.String `tfsdk:"id"`
+	Email types.String `tfsdk:"email"`
+}
+
+var (
+	_ model.InnerModel[sifflet.PublicReferenceByIdOrEmailDto] = &PublicApiOwnerModel{}
+)
+
+func (m PublicApiOwnerModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":    types.StringType,
+		"email": types.StringType,
+	}
+}
+
+func (m PublicApiOwnerModel) ToDto(_ context.Context) (sifflet.PublicReferenceByIdOrEmailDto, diag.Diagnostics) {
+	var id *uuid.UUID
+	if !m.ID.IsNull() && m.ID.ValueString() != "" {
+		idv, err := uuid.Parse(m.ID.ValueString())
+		if err != nil {
+			return sifflet.PublicReferenceByIdOrEmailDto{}, tfutils.ErrToDiags("Owner ID is not a valid UUID", err)
+		}
+		id = &idv
+	}
+	return sifflet.PublicReferenceByIdOrEmailDto{
+		Id:    id,
+		Email: m.Email.ValueStringPointer(),
+	}, diag.Diagnostics{}
+}
+
+func (m *PublicApiOwnerModel) FromDto(_ context.Context, dto sifflet.PublicReferenceByIdOrEmailDto) diag.Diagnostics {
+	if dto.Id != nil {
+		m.ID = types.StringValue(dto.Id.String())
+	} else {
+		m.ID = types.StringNull()
+	}
+	m.Email = types.StringPointerValue(dto.Email)
+	return diag.Diagnostics{}
+}
+
+// PublicApiTermModel is a reference to a business term, by ID and/or by name.
+type PublicApiTermModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+var (
+	_ model.InnerModel[sifflet.PublicReferenceByIdOrNameDto] = &PublicApiTermModel{}
+)
+
+func (m PublicApiTermModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	}
+}
+
+func (m PublicApiTermModel) ToDto(_ context.Context) (sifflet.PublicReferenceByIdOrNameDto, diag.Diagnostics) {
+	var id *uuid.UUID
+	if !m.ID.IsNull() && m.ID.ValueString() != "" {
+		idv, err := uuid.Parse(m.ID.ValueString())
+		if err != nil {
+			return sifflet.PublicReferenceByIdOrNameDto{}, tfutils.ErrToDiags("Term ID is not a valid UUID", err)
+		}
+		id = &idv
+	}
+	return sifflet.PublicReferenceByIdOrNameDto{
+		Id:   id,
+		Name: m.Name.ValueStringPointer(),
+	}, diag.Diagnostics{}
+}
+
+func (m *PublicApiTermModel) FromDto(_ context.Context, dto sifflet.PublicReferenceByIdOrNameDto) diag.Diagnostics {
+	if dto.Id != nil {
+		m.ID = types.StringValue(dto.Id.String())
+	} else {
+		m.ID = types.StringNull()
+	}
+	m.Name = types.StringPointerValue(dto.Name)
+	return diag.Diagnostics{}
+}
+
+// CustomMetadataValueItem is implemented by the union types used by the API to send custom metadata values
+// (the generated client defines a different union type for each endpoint).
+type CustomMetadataValueItem interface {
+	FromPublicCustomMetadataEntryStringReferenceDto(v sifflet.PublicCustomMetadataEntryStringReferenceDto) error
+	FromPublicCustomMetadataEntryLabelReferenceDto(v sifflet.PublicCustomMetadataEntryLabelReferenceDto) error
+	FromPublicCustomMetadataEntryTeamReferenceDto(v sifflet.PublicCustomMetadataEntryTeamReferenceDto) error
+	FromPublicCustomMetadataEntryUserReferenceDto(v sifflet.PublicCustomMetadataEntryUserReferenceDto) error
+}
+
+// PublicApiCustomMetadataValueModel is the value of a custom metadata field. Exactly one of the value attributes is set,
+// depending on the type of the custom metadata field.
+type PublicApiCustomMetadataValueModel struct {
+	Name        types.String `tfsdk:"name"`
+	StringValue types.String `tfsdk:"string_value"`
+	LabelValue  types.String `tfsdk:"label_value"`
+	TeamName    types.String `tfsdk:"team_name"`
+	UserEmail   types.String `tfsdk:"user_email"`
+}
+
+func (m PublicApiCustomMetadataValueModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":         types.StringType,
+		"string_value": types.StringType,
+		"label_value":  types.StringType,
+		"team_name":    types.StringType,
+		"user_email":   types.StringType,
+	}
+}
+
+// WriteDto stores the custom metadata value in the given union item.
+func (m PublicApiCustomMetadataValueModel) WriteDto(item CustomMetadataValueItem) diag.Diagnostics {
+	name := m.Name.ValueString()
+
+	var err error
+	switch {
+	case !m.StringValue.IsNull():
+		err = item.FromPublicCustomMetadataEntryStringReferenceDto(sifflet.PublicCustomMetadataEntryStringReferenceDto{
+			CustomMetadataName: name,
+			StringValue:        m.StringValue.ValueStringPointer(),
+			Type:               sifflet.PublicCustomMetadataEntryStringReferenceDtoTypeSTRING,
+		})
+	case !m.LabelValue.IsNull():
+		err = item.FromPublicCustomMetadataEntryLabelReferenceDto(sifflet.PublicCustomMetadataEntryLabelReferenceDto{
+			CustomMetadataName: name,
+			LabelValue:         m.LabelValue.ValueStringPointer(),
+			Type:               sifflet.PublicCustomMetadataEntryLabelReferenceDtoTypeLABEL,
+		})
+	case !m.TeamName.IsNull():
+		err = item.FromPublicCustomMetadataEntryTeamReferenceDto(sifflet.PublicCustomMetadataEntryTeamReferenceDto{
+			CustomMetadataName: name,
+			Name:               m.TeamName.ValueStringPointer(),
+			Type:               sifflet.PublicCustomMetadataEntryTeamReferenceDtoTypeTEAM,
+		})
+	case !m.UserEmail.IsNull():
+		err = item.FromPublicCustomMetadataEntryUserReferenceDto(sifflet.PublicCustomMetadataEntryUserReferenceDto{
+			CustomMetadataName: name,
+			Email:              m.UserEmail.ValueStringPointer(),
+			Type:               sifflet.PublicCustomMetadataEntryUserReferenceDtoTypeUSER,
+		})
+	default:
+		// This should not happen because of the validation in the terraform schema
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Invalid custom metadata value", "No value provided for custom metadata "+name),
+		}
+	}
+	if err != nil {
+		return tfutils.ErrToDiags("Unable to build custom metadata value", err)
+	}
+	return diag.Diagnostics{}
+}
+
+// FromDto updates the model with a custom metadata value returned by the API.
+func (m *PublicApiCustomMetadataValueModel) FromDto(item sifflet.PublicGetAssetDto_CustomMetadataValues_Item) diag.Diagnostics {
+	// All variants share the customMetadataName and type fields, decode them first to find the actual variant.
+	entry, err := item.AsPublicGetCustomMetadataEntryStringDto()
+	if err != nil {
+		return tfutils.ErrToDiags("Unable to read custom metadata value", err)
+	}
+
+	m.Name = types.StringValue(entry.CustomMetadataName)
+	m.StringValue = types.StringNull()
+	m.LabelValue = types.StringNull()
+	m.TeamName = types.StringNull()
+	m.UserEmail = types.StringNull()
+
+	switch string(entry.Type) {
+	case string(sifflet.PublicGetCustomMetadataEntryDtoTypeSTRING):
+		m.StringValue = types.StringValue(valueOrEmpty(entry.StringValue))
+	case string(sifflet.PublicGetCustomMetadataEntryDtoTypeLABEL):
+		label, err := item.AsPublicGetCustomMetadataEntryLabelDto()
+		if err != nil {
+			return tfutils.ErrToDiags("Unable to read custom metadata value", err)
+		}
+		m.LabelValue = types.StringValue(valueOrEmpty(label.LabelValue))
+	case string(sifflet.PublicGetCustomMetadataEntryDtoTypeTEAM):
+		team, err := item.AsPublicGetCustomMetadataEntryTeamDto()
+		if err != nil {
+			return tfutils.ErrToDiags("Unable to read custom metadata value", err)
+		}
+		m.TeamName = types.StringValue(valueOrEmpty(team.Name))
+	case string(sifflet.PublicGetCustomMetadataEntryDtoTypeUSER):
+		user, err := item.AsPublicGetCustomMetadataEntryUserDto()
+		if err != nil {
+			return tfutils.ErrToDiags("Unable to read custom metadata value", err)
+		}
+		m.UserEmail = types.StringValue(valueOrEmpty(user.Email))
+	default:
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unsupported custom metadata type", fmt.Sprintf("Custom metadata %s has unsupported type %s", entry.CustomMetadataName, entry.Type)),
+		}
+	}
+	return diag.Diagnostics{}
+}
+
+func valueOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// The functions below tell whether a reference from the configuration (which can omit some attributes, for instance
+// the ID of a tag referenced by name) designates the same object as a reference returned by the API.
+
+func tagMatches(configured tag.PublicApiTagModel, actual tag.PublicApiTagModel) bool {
+	if !configured.ID.IsNull() {
+		return strings.EqualFold(configured.ID.ValueString(), actual.ID.ValueString())
+	}
+	if !configured.Kind.IsNull() && configured.Kind.ValueString() != actual.Kind.ValueString() {
+		return false
+	}
+	return configured.Name.ValueString() == actual.Name.ValueString()
+}
+
+func ownerMatches(configured PublicApiOwnerModel, actual PublicApiOwnerModel) bool {
+	if !configured.ID.IsNull() {
+		return strings.EqualFold(configured.ID.ValueString(), actual.ID.ValueString())
+	}
+	return strings.EqualFold(configured.Email.ValueString(), actual.Email.ValueString())
+}
+
+func termMatches(configured PublicApiTermModel, actual PublicApiTermModel) bool {
+	if !configured.ID.IsNull() {
+		return strings.EqualFold(configured.ID.ValueString(), actual.ID.ValueString())
+	}
+	return configured.Name.ValueString() == actual.Name.ValueString()
+}
+
+func customMetadataValueMatches(configured PublicApiCustomMetadataValueModel, actual PublicApiCustomMetadataValueModel) bool {
+	return configured.Name.Equal(actual.Name) &&
+		configured.StringValue.Equal(actual.StringValue) &&
+		configured.LabelValue.Equal(actual.LabelValue) &&
+		configured.TeamName.Equal(actual.TeamName) &&
+		configured.UserEmail.Equal(actual.UserEmail)
+}
+
+// reconcileReferences returns the references read from the API, keeping the configured form of the references that
+// match a configured one (in their configured order). This avoids spurious differences when the configuration
+// omits some attributes of a reference. References that are not configured are appended at the end.
+func reconcileReferences[T any](configured []T, actual []T, matches func(configured T, actual T) bool) []T {
+	result := make([]T, 0, len(actual))
+	used := make([]bool, len(actual))
+	for _, c := range configured {
+		for i, a := range actual {
+			if !used[i] && matches(c, a) {
+				used[i] = true
+				result = append(result, c)
+				break
+			}
+		}
+	}
+	for i, a := range actual {
+		if !used[i] {
+			result = append(result, a)
+		}
+	}
+	return result
+}
@@ -0,0 +1,174 @@
+package asset
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// The functions below return resource schemas for catalog metadata references, shared by the resources that manage
+// asset metadata. They match the models defined in this package, and PublicApiTagModel in the tag package.
+
+// tagAttributes returns the attributes of a tag reference.
+func tagAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Tag ID. If provided, name and kind must be omitted.",
+			Optional:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Tag name. If provided, id must be omitted.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(
+					path.MatchRelative().AtParent().AtName("id"),
+					path.MatchRelative(),
+				),
+			},
+		},
+		"kind": schema.StringAttribute{
+			Description: "Tag kind (such as 'Tag' or 'Classification'). Use this field for disambiguation when several tags share the same name.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("Tag", "Classification"),
+				stringvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("id"),
+				),
+			},
+		},
+	}
+}
+
+// TagsResourceAttribute returns the schema of an optional list of tag references.
+func TagsResourceAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: tagAttributes(),
+		},
+	}
+}
+
+// TagResourceAttribute returns the schema of a single required tag reference. Updates require recreating the resource.
+func TagResourceAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Required:    true,
+		Attributes:  tagAttributes(),
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// ownerAttributes returns the attributes of an owner reference.
+func ownerAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "ID of the owner (a user or a team). Either id or email must be specified.",
+			Optional:    true,
+		},
+		"email": schema.StringAttribute{
+			Description: "Email of the owner (a user). Either id or email must be specified.",
+			Optional:    true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(
+					path.MatchRelative().AtParent().AtName("id"),
+					path.MatchRelative(),
+				),
+			},
+		},
+	}
+}
+
+// OwnersResourceAttribute returns the schema of an optional list of owner references.
+func OwnersResourceAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: ownerAttributes(),
+		},
+	}
+}
+
+// OwnerResourceAttribute returns the schema of a single required owner reference. Updates require recreating the resource.
+func OwnerResourceAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Required:    true,
+		Attributes:  ownerAttributes(),
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// TermsResourceAttribute returns the schema of an optional list of business term references.
+func TermsResourceAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description: "Business term ID. At least one of id or name must be specified.",
+					Optional:    true,
+				},
+				"name": schema.StringAttribute{
+					Description: "Business term name. At least one of id or name must be specified.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.AtLeastOneOf(
+							path.MatchRelative().AtParent().AtName("id"),
+							path.MatchRelative(),
+						),
+					},
+				},
+			},
+		},
+	}
+}
+
+// CustomMetadataValuesResourceAttribute returns the schema of an optional list of custom metadata values.
+func CustomMetadataValuesResourceAttribute(description string) schema.ListNestedAttribute {
+	valueValidator := stringvalidator.ExactlyOneOf(
+		path.MatchRelative().AtParent().AtName("string_value"),
+		path.MatchRelative().AtParent().AtName("label_value"),
+		path.MatchRelative().AtParent().AtName("team_name"),
+		path.MatchRelative().AtParent().AtName("user_email"),
+	)
+	return schema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "Name of the custom metadata field.",
+					Required:    true,
+				},
+				"string_value": schema.StringAttribute{
+					Description: "Value of a custom metadata field of type string. Exactly one of string_value, label_value, team_name or user_email must be specified, depending on the type of the custom metadata field.",
+					Optional:    true,
+					Validators:  []validator.String{valueValidator},
+				},
+				"label_value": schema.StringAttribute{
+					Description: "Value of a custom metadata field of type label.",
+					Optional:    true,
+				},
+				"team_name": schema.StringAttribute{
+					Description: "Name of the team, for a custom metadata field of type team.",
+					Optional:    true,
+				},
+				"user_email": schema.StringAttribute{
+					Description: "Email of the user, for a custom metadata field of type user.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
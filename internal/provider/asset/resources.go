@@ -6,12 +6,17 @@ import (
 )
 
 func Resources() []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		newAssetMetadataResource,
+		newAssetOwnerResource,
+		newAssetTagResource,
+	}
 }
 
 func DataSources() []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newAssetDataSource,
 		newAssetsDataSource,
+		newDomainAssetsDataSource,
 	}
 }
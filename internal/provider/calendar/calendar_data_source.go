@@ -0,0 +1,151 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var (
+	_ datasource.DataSource              = &calendarDataSource{}
+	_ datasource.DataSourceWithConfigure = &calendarDataSource{}
+)
+
+func newCalendarDataSource() datasource.DataSource {
+	return &calendarDataSource{}
+}
+
+type calendarDataSource struct {
+	client *sifflet.ClientWithResponses
+}
+
+func (d *calendarDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Client
+}
+
+func (d *calendarDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_calendar"
+}
+
+// calendarAttributes returns the computed attributes of a calendar, shared by the calendar and calendars data sources.
+func calendarAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the calendar.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the calendar.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "The description of the calendar.",
+			Computed:    true,
+		},
+		"timeslots": schema.SetNestedAttribute{
+			Description: "The dates included in the calendar. Empty for standard calendars, whose dates are computed by Sifflet.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"date": schema.StringAttribute{
+						Description: "The date of the timeslot, in YYYY-MM-DD format.",
+						Computed:    true,
+					},
+					"description": schema.StringAttribute{
+						Description: "The description of the timeslot.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"standard_calendar": schema.StringAttribute{
+			Description: "For calendars provided by Sifflet, the kind of standard calendar (such as 'WEEKENDS' or 'US_PUBLIC_HOLIDAYS'). Null for custom calendars.",
+			Computed:    true,
+		},
+		"editable": schema.BoolAttribute{
+			Description: "Whether the calendar can be edited. Standard calendars provided by Sifflet can't be edited.",
+			Computed:    true,
+		},
+	}
+}
+
+func CalendarDataSourceSchema(ctx context.Context) schema.Schema {
+	attributes := calendarAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The ID of the calendar.",
+		Required:    true,
+	}
+	return schema.Schema{
+		Description: "Read a Sifflet calendar by its ID. Both custom calendars and standard calendars provided by Sifflet can be read.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *calendarDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = CalendarDataSourceSchema(ctx)
+}
+
+func (d *calendarDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var data calendarDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to parse calendar ID", err.Error())
+		return
+	}
+
+	calendarResponse, err := d.client.PublicGetCalendarWithResponse(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read calendar", err.Error())
+		return
+	}
+
+	if calendarResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(
+			ctx, &resp.Diagnostics, "Unable to read calendar",
+			calendarResponse.StatusCode(), calendarResponse.Body,
+		)
+		return
+	}
+
+	diags := data.FromDto(ctx, *calendarResponse.JSON200)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
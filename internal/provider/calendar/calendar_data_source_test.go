@@ -0,0 +1,72 @@
+package calendar_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCalendarDataSources(t *testing.T) {
+	calendarName := providertests.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_calendar" "test" {
+						name = "%s"
+						description = "Created by Terraform provider tests"
+						timeslots = [{
+							date = "2030-01-01"
+						}]
+					}
+
+					data "sifflet_calendar" "test" {
+						id = sifflet_calendar.test.id
+					}
+
+					data "sifflet_calendars" "test" {
+						depends_on = [sifflet_calendar.test]
+					}
+				`, calendarName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sifflet_calendar.test", "id", "sifflet_calendar.test", "id"),
+					resource.TestCheckResourceAttr("data.sifflet_calendar.test", "name", calendarName),
+					resource.TestCheckResourceAttr("data.sifflet_calendar.test", "description", "Created by Terraform provider tests"),
+					resource.TestCheckResourceAttr("data.sifflet_calendar.test", "timeslots.#", "1"),
+					resource.TestCheckResourceAttr("data.sifflet_calendar.test", "timeslots.0.date", "2030-01-01"),
+					resource.TestCheckResourceAttr("data.sifflet_calendar.test", "editable", "true"),
+					resource.TestCheckNoResourceAttr("data.sifflet_calendar.test", "standard_calendar"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sifflet_calendars.test", "calendars.*", map[string]string{
+						"name": calendarName,
+					}),
+					// Standard calendars are present on all Sifflet instances.
+					resource.TestCheckTypeSetElemNestedAttrs("data.sifflet_calendars.test", "calendars.*", map[string]string{
+						"standard_calendar": "WEEKENDS",
+						"editable":          "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCalendarDataSourceError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_calendar" "test" {
+					id = "00000000-0000-0000-0000-000000000000"
+				}`,
+				ExpectError: regexp.MustCompile("HTTP status code: 404"),
+			},
+		},
+	})
+}
@@ -0,0 +1,278 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+var (
+	_ resource.Resource                = &calendarResource{}
+	_ resource.ResourceWithConfigure   = &calendarResource{}
+	_ resource.ResourceWithImportState = &calendarResource{}
+)
+
+func newCalendarResource() resource.Resource {
+	return &calendarResource{}
+}
+
+type calendarResource struct {
+	client *sifflet.ClientWithResponses
+}
+
+// Metadata returns the resource type name.
+func (r *calendarResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_calendar"
+}
+
+func calendarResourceSchema() schema.Schema {
+	return schema.Schema{
+		Description:         "A Sifflet custom calendar.",
+		MarkdownDescription: "A Sifflet custom calendar. A calendar is a set of dates (such as public holidays or maintenance days) that monitors can use to skip runs or ignore results.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the calendar.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the calendar.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the calendar.",
+				Optional:    true,
+			},
+			"timeslots": schema.SetNestedAttribute{
+				Description: "The dates included in the calendar. Can be empty.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							Description: "The date of the timeslot, in YYYY-MM-DD format.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the timeslot (for instance, the name of the holiday).",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *calendarResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = calendarResourceSchema()
+}
+
+func (r *calendarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := tfutils.WithDefaultCreateTimeout(ctx)
+	defer cancel()
+
+	var plan calendarModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	calendarDto, diags := plan.ToCreateDto(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	calendarResponse, err := r.client.PublicCreateCalendarWithResponse(ctx, calendarDto)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create calendar", err.Error())
+		return
+	}
+
+	if calendarResponse.StatusCode() != http.StatusCreated {
+		sifflet.HandleHttpErrorAsProblem(
+			ctx, &resp.Diagnostics, "Unable to create calendar", calendarResponse.StatusCode(), calendarResponse.Body,
+		)
+		return
+	}
+
+	var newState calendarModel
+	diags = newState.FromDto(ctx, *calendarResponse.JSON201)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *calendarResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var state calendarModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := state.ModelId()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	calendarResponse, err := r.client.PublicGetCalendarWithResponse(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read calendar", err.Error())
+		return
+	}
+
+	if calendarResponse.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if calendarResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(
+			ctx, &resp.Diagnostics, "Unable to read calendar", calendarResponse.StatusCode(), calendarResponse.Body,
+		)
+		return
+	}
+
+	var newState calendarModel
+	diags = newState.FromDto(ctx, *calendarResponse.JSON200)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *calendarResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := tfutils.WithDefaultUpdateTimeout(ctx)
+	defer cancel()
+
+	var plan calendarModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := plan.ModelId()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	calendarDto, diags := plan.ToUpdateDto(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	calendarResponse, err := r.client.PublicUpdateCalendarWithResponse(ctx, id, calendarDto)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to update calendar", err.Error())
+		return
+	}
+
+	if calendarResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(
+			ctx, &resp.Diagnostics, "Unable to update calendar", calendarResponse.StatusCode(), calendarResponse.Body,
+		)
+		return
+	}
+
+	var newState calendarModel
+	diags = newState.FromDto(ctx, *calendarResponse.JSON200)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *calendarResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := tfutils.WithDefaultDeleteTimeout(ctx)
+	defer cancel()
+
+	var state calendarModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, diags := state.ModelId()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	calendarResponse, err := r.client.PublicDeleteCalendarWithResponse(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to delete calendar", err.Error())
+		return
+	}
+
+	if calendarResponse.StatusCode() != http.StatusNoContent {
+		sifflet.HandleHttpErrorAsProblem(
+			ctx, &resp.Diagnostics, "Unable to delete calendar", calendarResponse.StatusCode(), calendarResponse.Body,
+		)
+		return
+	}
+}
+
+func (r *calendarResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *calendarResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Client
+}
@@ -0,0 +1,129 @@
+package calendar_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("sifflet_calendar", &resource.Sweeper{
+		Name: "sifflet_calendar",
+		F: func(region string) error {
+			ctx := context.Background()
+			client, err := provider.ClientForSweepers(ctx)
+			if err != nil {
+				return fmt.Errorf("Error creating HTTP client: %s", err)
+			}
+
+			calendars, err := client.PublicGetCalendarsWithResponse(ctx)
+			if err != nil {
+				return fmt.Errorf("Error listing calendars: %s", err)
+			}
+			if calendars.StatusCode() != 200 {
+				return fmt.Errorf("Error listing calendars: status code %s", calendars.Status())
+			}
+			for _, calendar := range calendars.JSON200.Data {
+				if strings.HasPrefix(calendar.Name, providertests.AcceptanceTestPrefix()) {
+					_, err := client.PublicDeleteCalendarWithResponse(ctx, calendar.Id)
+					if err != nil {
+						return fmt.Errorf("Error deleting calendar %s: %s", calendar.Name, err)
+					}
+					fmt.Printf("Deleted dangling calendar %s\n", calendar.Name)
+				}
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccCalendarResource(t *testing.T) {
+	calendarName := providertests.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_calendar" "test" {
+						name = "%s"
+						description = "Created by Terraform provider tests"
+						timeslots = [
+							{
+								date = "2030-01-01"
+								description = "New Year's Day"
+							},
+							{
+								date = "2030-12-25"
+							},
+						]
+					}
+				`, calendarName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sifflet_calendar.test", "id"),
+					resource.TestCheckResourceAttr("sifflet_calendar.test", "name", calendarName),
+					resource.TestCheckResourceAttr("sifflet_calendar.test", "description", "Created by Terraform provider tests"),
+					resource.TestCheckResourceAttr("sifflet_calendar.test", "timeslots.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("sifflet_calendar.test", "timeslots.*", map[string]string{
+						"date":        "2030-01-01",
+						"description": "New Year's Day",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("sifflet_calendar.test", "timeslots.*", map[string]string{
+						"date": "2030-12-25",
+					}),
+				),
+			},
+			{
+				ResourceName:                         "sifflet_calendar.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "id",
+			},
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_calendar" "test" {
+						name = "%s-updated"
+						timeslots = [
+							{
+								date = "2030-05-01"
+								description = "Labour Day"
+							},
+						]
+					}
+				`, calendarName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_calendar.test", "name", calendarName+"-updated"),
+					resource.TestCheckNoResourceAttr("sifflet_calendar.test", "description"),
+					resource.TestCheckResourceAttr("sifflet_calendar.test", "timeslots.#", "1"),
+					resource.TestCheckResourceAttr("sifflet_calendar.test", "timeslots.0.date", "2030-05-01"),
+					resource.TestCheckResourceAttr("sifflet_calendar.test", "timeslots.0.description", "Labour Day"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCalendarInvalidDate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_calendar" "test" {
+						name = "%s"
+						timeslots = [{
+							date = "01/01/2030"
+						}]
+					}
+				`, providertests.RandomName()),
+				ExpectError: regexp.MustCompile("Could not parse timeslot date"),
+			},
+		},
+	})
+}
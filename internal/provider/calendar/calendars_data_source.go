@@ -0,0 +1,121 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &calendarsDataSource{}
+	_ datasource.DataSourceWithConfigure = &calendarsDataSource{}
+)
+
+func newCalendarsDataSource() datasource.DataSource {
+	return &calendarsDataSource{}
+}
+
+type calendarsDataSource struct {
+	client *sifflet.ClientWithResponses
+}
+
+func (d *calendarsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Client
+}
+
+func (d *calendarsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_calendars"
+}
+
+func CalendarsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Return all Sifflet calendars, including the standard calendars provided by Sifflet.",
+		Attributes: map[string]schema.Attribute{
+			"calendars": schema.ListNestedAttribute{
+				Description: "List of calendars.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: calendarAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *calendarsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = CalendarsDataSourceSchema(ctx)
+}
+
+type calendarsDataSourceModel struct {
+	Calendars types.List `tfsdk:"calendars"`
+}
+
+func (d *calendarsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var data calendarsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	calendarsResponse, err := d.client.PublicGetCalendarsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read calendars", err.Error())
+		return
+	}
+
+	if calendarsResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(
+			ctx, &resp.Diagnostics, "Unable to read calendars",
+			calendarsResponse.StatusCode(), calendarsResponse.Body,
+		)
+		return
+	}
+
+	calendars, diags := tfutils.MapWithDiagnostics(calendarsResponse.JSON200.Data, func(dto sifflet.PublicCalendarGetDto) (calendarDataSourceModel, diag.Diagnostics) {
+		var m calendarDataSourceModel
+		diags := m.FromDto(ctx, dto)
+		return m, diags
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Calendars, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: calendarDataSourceModel{}.AttributeTypes()}, calendars)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
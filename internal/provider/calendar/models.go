@@ -0,0 +1,174 @@
+package calendar
+
+import (
+	"context"
+	"time"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/model"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type calendarModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Timeslots   types.Set    `tfsdk:"timeslots"`
+}
+
+var (
+	_ model.FullModel[sifflet.PublicCalendarGetDto, sifflet.PublicCalendarCreateDto, sifflet.PublicCalendarUpdateDto] = &calendarModel{}
+	_ model.ModelWithId[uuid.UUID]                                                                                    = calendarModel{}
+)
+
+func (m calendarModel) ModelId() (uuid.UUID, diag.Diagnostics) {
+	id, err := uuid.Parse(m.Id.ValueString())
+	if err != nil {
+		return uuid.Nil, tfutils.ErrToDiags("Could not parse ID as UUID", err)
+	}
+	return id, diag.Diagnostics{}
+}
+
+func (m calendarModel) getTimeslotsDto(ctx context.Context) ([]sifflet.CalendarTimeslot, diag.Diagnostics) {
+	timeslots := make([]timeslotModel, 0, len(m.Timeslots.Elements()))
+	diags := m.Timeslots.ElementsAs(ctx, &timeslots, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return tfutils.MapWithDiagnostics(timeslots, func(timeslot timeslotModel) (sifflet.CalendarTimeslot, diag.Diagnostics) {
+		return timeslot.ToDto(ctx)
+	})
+}
+
+func (m calendarModel) ToCreateDto(ctx context.Context) (sifflet.PublicCalendarCreateDto, diag.Diagnostics) {
+	timeslots, diags := m.getTimeslotsDto(ctx)
+	if diags.HasError() {
+		return sifflet.PublicCalendarCreateDto{}, diags
+	}
+
+	return sifflet.PublicCalendarCreateDto{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueStringPointer(),
+		Timeslots:   timeslots,
+	}, diag.Diagnostics{}
+}
+
+func (m calendarModel) ToUpdateDto(ctx context.Context) (sifflet.PublicCalendarUpdateDto, diag.Diagnostics) {
+	timeslots, diags := m.getTimeslotsDto(ctx)
+	if diags.HasError() {
+		return sifflet.PublicCalendarUpdateDto{}, diags
+	}
+
+	return sifflet.PublicCalendarUpdateDto{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueStringPointer(),
+		Timeslots:   timeslots,
+	}, diag.Diagnostics{}
+}
+
+func (m *calendarModel) FromDto(ctx context.Context, dto sifflet.PublicCalendarGetDto) diag.Diagnostics {
+	timeslots, diags := model.NewModelSetFromDto(
+		ctx, dto.Timeslots,
+		func() model.InnerModel[sifflet.CalendarTimeslot] { return &timeslotModel{} },
+	)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.Id = types.StringValue(dto.Id.String())
+	m.Name = types.StringValue(dto.Name)
+	m.Description = types.StringPointerValue(dto.Description)
+	m.Timeslots = timeslots
+	return diag.Diagnostics{}
+}
+
+// calendarDataSourceModel is the read-only representation of a calendar, used by the data sources.
+// Unlike calendarModel, it also exposes attributes that only make sense for calendars managed by Sifflet.
+type calendarDataSourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Timeslots        types.Set    `tfsdk:"timeslots"`
+	StandardCalendar types.String `tfsdk:"standard_calendar"`
+	Editable         types.Bool   `tfsdk:"editable"`
+}
+
+var (
+	_ model.ReadableModel[sifflet.PublicCalendarGetDto] = &calendarDataSourceModel{}
+)
+
+func (m calendarDataSourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"timeslots": types.SetType{
+			ElemType: types.ObjectType{AttrTypes: timeslotModel{}.AttributeTypes()},
+		},
+		"standard_calendar": types.StringType,
+		"editable":          types.BoolType,
+	}
+}
+
+func (m *calendarDataSourceModel) FromDto(ctx context.Context, dto sifflet.PublicCalendarGetDto) diag.Diagnostics {
+	timeslots, diags := model.NewModelSetFromDto(
+		ctx, dto.Timeslots,
+		func() model.InnerModel[sifflet.CalendarTimeslot] { return &timeslotModel{} },
+	)
+	if diags.HasError() {
+		return diags
+	}
+
+	var standardCalendar *string
+	if dto.StandardCalendar != nil {
+		s := string(*dto.StandardCalendar)
+		standardCalendar = &s
+	}
+
+	m.Id = types.StringValue(dto.Id.String())
+	m.Name = types.StringValue(dto.Name)
+	m.Description = types.StringPointerValue(dto.Description)
+	m.Timeslots = timeslots
+	m.StandardCalendar = types.StringPointerValue(standardCalendar)
+	m.Editable = types.BoolPointerValue(dto.Editable)
+	return diag.Diagnostics{}
+}
+
+type timeslotModel struct {
+	Date        types.String `tfsdk:"date"`
+	Description types.String `tfsdk:"description"`
+}
+
+var (
+	_ model.InnerModel[sifflet.CalendarTimeslot] = &timeslotModel{}
+)
+
+func (m timeslotModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"date":        types.StringType,
+		"description": types.StringType,
+	}
+}
+
+func (m *timeslotModel) FromDto(_ context.Context, dto sifflet.CalendarTimeslot) diag.Diagnostics {
+	m.Date = types.StringValue(dto.Date.String())
+	m.Description = types.StringPointerValue(dto.Description)
+	return diag.Diagnostics{}
+}
+
+func (m timeslotModel) ToDto(_ context.Context) (sifflet.CalendarTimeslot, diag.Diagnostics) {
+	date, err := time.Parse(openapi_types.DateFormat, m.Date.ValueString())
+	if err != nil {
+		return sifflet.CalendarTimeslot{}, tfutils.ErrToDiags("Could not parse timeslot date, expected format is YYYY-MM-DD", err)
+	}
+	return sifflet.CalendarTimeslot{
+		Date:        openapi_types.Date{Time: date},
+		Description: m.Description.ValueStringPointer(),
+	}, diag.Diagnostics{}
+}
@@ -0,0 +1,19 @@
+package calendar
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		newCalendarResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		newCalendarDataSource,
+		newCalendarsDataSource,
+	}
+}
@@ -0,0 +1,225 @@
+package dbt
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource               = &dbtArtifactsResource{}
+	_ resource.ResourceWithConfigure  = &dbtArtifactsResource{}
+	_ resource.ResourceWithModifyPlan = &dbtArtifactsResource{}
+)
+
+func newDbtArtifactsResource() resource.Resource {
+	return &dbtArtifactsResource{}
+}
+
+type dbtArtifactsResource struct {
+	client *sifflet.ClientWithResponses
+}
+
+// Metadata returns the resource type name.
+func (r *dbtArtifactsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dbt_artifacts"
+}
+
+// Schema defines the schema for the resource.
+func (r *dbtArtifactsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads dbt Core artifacts (manifest, catalog and run results) to Sifflet.",
+		MarkdownDescription: "Uploads dbt Core artifacts (`manifest.json`, `catalog.json` and `run_results.json`) to Sifflet, for a dbt source (a `sifflet_source_v2` resource with `dbt` parameters). " +
+			"The files are uploaded again whenever their content changes.\n\n" +
+			"The Sifflet API doesn't allow deleting uploaded artifacts: destroying this resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the resource, made of the project name and of the target.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_name": schema.StringAttribute{
+				Description: "The dbt project name (the 'name' value in your dbt_project.yml file). Must match the project name of the dbt source. Updates require recreating the resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target": schema.StringAttribute{
+				Description: "The dbt target name (the 'target' value in your profiles.yml file). Must match the target of the dbt source. Updates require recreating the resource.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest_path": schema.StringAttribute{
+				Description: "Path to the manifest.json file generated by dbt.",
+				Required:    true,
+			},
+			"catalog_path": schema.StringAttribute{
+				Description: "Path to the catalog.json file generated by dbt (with `dbt docs generate`).",
+				Optional:    true,
+			},
+			"run_results_path": schema.StringAttribute{
+				Description: "Path to the run_results.json file generated by dbt.",
+				Optional:    true,
+			},
+			"manifest_sha256": schema.StringAttribute{
+				Description: "SHA-256 hash of the uploaded manifest file.",
+				Computed:    true,
+			},
+			"catalog_sha256": schema.StringAttribute{
+				Description: "SHA-256 hash of the uploaded catalog file.",
+				Computed:    true,
+			},
+			"run_results_sha256": schema.StringAttribute{
+				Description: "SHA-256 hash of the uploaded run results file.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan computes the hashes of the artifact files, so that the files are uploaded again when their content changes.
+func (r *dbtArtifactsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// The resource is being destroyed
+		return
+	}
+
+	var plan dbtArtifactsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(plan.computeHashes()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// upload sends the artifact files to the API, and returns the new state.
+func (r *dbtArtifactsResource) upload(ctx context.Context, plan dbtArtifactsModel, summary string) (dbtArtifactsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newState := plan
+	newState.Id = types.StringValue(fmt.Sprintf("%s/%s", plan.ProjectName.ValueString(), plan.Target.ValueString()))
+	if newState.hasUnknownHashes() {
+		// The file paths were not known during planning
+		diags = newState.computeHashes()
+		if diags.HasError() {
+			return dbtArtifactsModel{}, diags
+		}
+	}
+
+	body, contentType, diags := newState.toMultipartBody()
+	if diags.HasError() {
+		return dbtArtifactsModel{}, diags
+	}
+
+	uploadResponse, err := r.client.UploadDbtMetadataFilesWithBodyWithResponse(
+		ctx, plan.ProjectName.ValueString(), plan.Target.ValueString(), contentType, body,
+	)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return dbtArtifactsModel{}, diags
+	}
+
+	if uploadResponse.StatusCode() != http.StatusNoContent {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, uploadResponse.StatusCode(), uploadResponse.Body)
+		return dbtArtifactsModel{}, diags
+	}
+
+	return newState, diags
+}
+
+func (r *dbtArtifactsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := tfutils.WithDefaultCreateTimeout(ctx)
+	defer cancel()
+
+	var plan dbtArtifactsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.upload(ctx, plan, "Unable to upload dbt artifacts")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dbtArtifactsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The API does not allow reading back uploaded artifacts, the state is kept as is.
+	// Changes to the local files are detected during planning.
+}
+
+func (r *dbtArtifactsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := tfutils.WithDefaultUpdateTimeout(ctx)
+	defer cancel()
+
+	var plan dbtArtifactsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.upload(ctx, plan, "Unable to upload dbt artifacts")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dbtArtifactsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The API does not allow deleting uploaded artifacts, the resource is only removed from the state.
+}
+
+func (r *dbtArtifactsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Client
+}
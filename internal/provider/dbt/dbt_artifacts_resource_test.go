@@ -0,0 +1,95 @@
+package dbt_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func writeArtifact(t *testing.T, dir string, name string, content string) string {
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestAccDbtArtifactsResource(t *testing.T) {
+	sourceName := providertests.RandomName()
+	projectName := providertests.RandomName()
+	dir := t.TempDir()
+	manifestPath := writeArtifact(t, dir, "manifest.json", `{"metadata": {"project_name": "`+projectName+`"}, "nodes": {}, "sources": {}}`)
+	runResultsPath := writeArtifact(t, dir, "run_results.json", `{"metadata": {}, "results": []}`)
+
+	config := providertests.ProviderConfig() + fmt.Sprintf(`
+		resource "sifflet_source_v2" "test" {
+			name = "%s"
+			parameters = {
+				dbt = {
+					project_name = "%s"
+					target = "prod"
+				}
+			}
+		}
+
+		resource "sifflet_dbt_artifacts" "test" {
+			project_name = sifflet_source_v2.test.parameters.dbt.project_name
+			target = sifflet_source_v2.test.parameters.dbt.target
+			manifest_path = "%s"
+			run_results_path = "%s"
+		}
+	`, sourceName, projectName, manifestPath, runResultsPath)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_dbt_artifacts.test", "id", projectName+"/prod"),
+					resource.TestCheckResourceAttrSet("sifflet_dbt_artifacts.test", "manifest_sha256"),
+					resource.TestCheckResourceAttrSet("sifflet_dbt_artifacts.test", "run_results_sha256"),
+					resource.TestCheckNoResourceAttr("sifflet_dbt_artifacts.test", "catalog_sha256"),
+				),
+			},
+			{
+				// Changing the content of a file triggers a new upload
+				PreConfig: func() {
+					writeArtifact(t, dir, "run_results.json", `{"metadata": {}, "results": [], "elapsed_time": 1.0}`)
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sifflet_dbt_artifacts.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDbtArtifactsMissingFile(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_dbt_artifacts" "test" {
+						project_name = "project"
+						target = "prod"
+						manifest_path = "%s"
+					}
+				`, filepath.Join(t.TempDir(), "does_not_exist.json")),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unable to read dbt manifest file"),
+			},
+		},
+	})
+}
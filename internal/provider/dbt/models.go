@@ -0,0 +1,112 @@
+package dbt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dbtArtifactsModel struct {
+	Id               types.String `tfsdk:"id"`
+	ProjectName      types.String `tfsdk:"project_name"`
+	Target           types.String `tfsdk:"target"`
+	ManifestPath     types.String `tfsdk:"manifest_path"`
+	CatalogPath      types.String `tfsdk:"catalog_path"`
+	RunResultsPath   types.String `tfsdk:"run_results_path"`
+	ManifestSha256   types.String `tfsdk:"manifest_sha256"`
+	CatalogSha256    types.String `tfsdk:"catalog_sha256"`
+	RunResultsSha256 types.String `tfsdk:"run_results_sha256"`
+}
+
+// artifact is a dbt artifact file, as sent in the multipart upload request.
+type artifact struct {
+	// field is the name of the multipart field expected by the API.
+	field string
+	path  types.String
+	hash  *types.String
+}
+
+// artifacts returns the artifacts of the model. Optional artifacts that are not set have a null path.
+func (m *dbtArtifactsModel) artifacts() []artifact {
+	return []artifact{
+		{field: "manifest", path: m.ManifestPath, hash: &m.ManifestSha256},
+		{field: "catalog", path: m.CatalogPath, hash: &m.CatalogSha256},
+		{field: "run_results", path: m.RunResultsPath, hash: &m.RunResultsSha256},
+	}
+}
+
+// computeHashes sets the hashes of the artifact files. The hash of an artifact with an unknown path is unknown, and
+// the hash of an artifact with a null path is null.
+func (m *dbtArtifactsModel) computeHashes() diag.Diagnostics {
+	for _, a := range m.artifacts() {
+		switch {
+		case a.path.IsUnknown():
+			*a.hash = types.StringUnknown()
+		case a.path.IsNull():
+			*a.hash = types.StringNull()
+		default:
+			content, err := os.ReadFile(a.path.ValueString()) // nolint: gosec
+			if err != nil {
+				return tfutils.ErrToDiags(fmt.Sprintf("Unable to read dbt %s file", a.field), err)
+			}
+			hash := sha256.Sum256(content)
+			*a.hash = types.StringValue(hex.EncodeToString(hash[:]))
+		}
+	}
+	return diag.Diagnostics{}
+}
+
+func (m *dbtArtifactsModel) hasUnknownHashes() bool {
+	for _, a := range m.artifacts() {
+		if a.hash.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+// toMultipartBody builds the body of the upload request, and returns it along with its content type.
+func (m *dbtArtifactsModel) toMultipartBody() (*bytes.Buffer, string, diag.Diagnostics) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for _, a := range m.artifacts() {
+		if a.path.IsNull() {
+			continue
+		}
+		diags := writeFile(writer, a.field, a.path.ValueString())
+		if diags.HasError() {
+			return nil, "", diags
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", tfutils.ErrToDiags("Unable to build dbt artifacts upload request", err)
+	}
+	return body, writer.FormDataContentType(), diag.Diagnostics{}
+}
+
+func writeFile(writer *multipart.Writer, field string, path string) diag.Diagnostics {
+	file, err := os.Open(path) // nolint: gosec
+	if err != nil {
+		return tfutils.ErrToDiags(fmt.Sprintf("Unable to read dbt %s file", field), err)
+	}
+	defer func() { _ = file.Close() }()
+
+	part, err := writer.CreateFormFile(field, filepath.Base(path))
+	if err != nil {
+		return tfutils.ErrToDiags("Unable to build dbt artifacts upload request", err)
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return tfutils.ErrToDiags(fmt.Sprintf("Unable to read dbt %s file", field), err)
+	}
+	return diag.Diagnostics{}
+}
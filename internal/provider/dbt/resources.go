@@ -0,0 +1,16 @@
+package dbt
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		newDbtArtifactsResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
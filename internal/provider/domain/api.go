@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// getDomain reads a domain by ID.
+func getDomain(ctx context.Context, client *sifflet.ClientWithResponses, id uuid.UUID, summary string) (sifflet.PublicGetDomainDto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	domainResponse, err := client.PublicGetDomainWithResponse(ctx, id)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return sifflet.PublicGetDomainDto{}, diags
+	}
+
+	if domainResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, domainResponse.StatusCode(), domainResponse.Body)
+		return sifflet.PublicGetDomainDto{}, diags
+	}
+
+	return *domainResponse.JSON200, diags
+}
+
+// setTeamDomainRole sets the role of a team in a domain. The team loses its access to the domain if role is nil.
+// The API only allows editing domain permissions from the team side: the team is read and updated as a whole,
+// and its other permissions, users, name and description are sent back unchanged.
+func setTeamDomainRole(ctx context.Context, client *sifflet.ClientWithResponses, teamId uuid.UUID, domainId uuid.UUID, role *sifflet.PublicDomainTeamPermissionDtoDomainRole, summary string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	teamResponse, err := client.PublicGetTeamWithResponse(ctx, teamId)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return diags
+	}
+
+	if teamResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, teamResponse.StatusCode(), teamResponse.Body)
+		return diags
+	}
+	teamDto := *teamResponse.JSON200
+
+	permissions := make([]sifflet.PublicTeamPermissionAssignmentDto, 0)
+	if teamDto.DomainPermissions != nil {
+		for _, permission := range *teamDto.DomainPermissions {
+			if permission.DomainId != domainId {
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+	if role != nil {
+		permissions = append(permissions, sifflet.PublicTeamPermissionAssignmentDto{
+			DomainId:   domainId,
+			DomainRole: sifflet.PublicTeamPermissionAssignmentDtoDomainRole(*role),
+		})
+	}
+
+	updateResponse, err := client.PublicUpdateTeamWithResponse(ctx, teamId, sifflet.PublicUpdateTeamDto{
+		Name:              teamDto.Name,
+		Description:       teamDto.Description,
+		DomainPermissions: &permissions,
+		Users:             teamDto.Users,
+	})
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return diags
+	}
+
+	if updateResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, updateResponse.StatusCode(), updateResponse.Body)
+		return diags
+	}
+
+	return diags
+}
+
+// updateTeamPermissions grants the wanted roles in a domain to teams, given the current team permissions of the domain.
+// Teams in revoked that are not in wanted lose their access to the domain.
+func updateTeamPermissions(
+	ctx context.Context, client *sifflet.ClientWithResponses, domainId uuid.UUID,
+	current []sifflet.PublicDomainTeamPermissionDto, wanted []sifflet.PublicDomainTeamPermissionDto, revoked []uuid.UUID,
+	summary string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	currentRoles := make(map[uuid.UUID]sifflet.PublicDomainTeamPermissionDtoDomainRole, len(current))
+	for _, permission := range current {
+		currentRoles[permission.TeamId] = permission.DomainRole
+	}
+	wantedRoles := make(map[uuid.UUID]sifflet.PublicDomainTeamPermissionDtoDomainRole, len(wanted))
+	for _, permission := range wanted {
+		if _, ok := wantedRoles[permission.TeamId]; ok {
+			diags.AddError(summary, fmt.Sprintf("Team %s is listed several times in the team permissions", permission.TeamId))
+			return diags
+		}
+		wantedRoles[permission.TeamId] = permission.DomainRole
+	}
+
+	for _, permission := range wanted {
+		if currentRole, ok := currentRoles[permission.TeamId]; ok && currentRole == permission.DomainRole {
+			continue
+		}
+		diags.Append(setTeamDomainRole(ctx, client, permission.TeamId, domainId, &permission.DomainRole, summary)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	for _, teamId := range revoked {
+		if _, ok := wantedRoles[teamId]; ok {
+			continue
+		}
+		if _, ok := currentRoles[teamId]; !ok {
+			continue
+		}
+		diags.Append(setTeamDomainRole(ctx, client, teamId, domainId, nil, summary)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// listDomains returns all the domains, going through all the pages of results.
+func listDomains(ctx context.Context, client *sifflet.ClientWithResponses, summary string) ([]sifflet.PublicGetDomainDto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	domains := make([]sifflet.PublicGetDomainDto, 0)
+	var itemsPerPage int32 = 100
+	for page := int32(0); ; page++ {
+		domainsResponse, err := client.PublicGetDomainsWithResponse(ctx, &sifflet.PublicGetDomainsParams{
+			Page:         &page,
+			ItemsPerPage: &itemsPerPage,
+		})
+		if err != nil {
+			diags.AddError(summary, err.Error())
+			return nil, diags
+		}
+
+		if domainsResponse.StatusCode() != http.StatusOK {
+			sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, domainsResponse.StatusCode(), domainsResponse.Body)
+			return nil, diags
+		}
+
+		domains = append(domains, domainsResponse.JSON200.Data...)
+		if int32(len(domainsResponse.JSON200.Data)) < itemsPerPage { // nolint: gosec
+			// Last page
+			return domains, diags
+		}
+	}
+}
+
+// findDomainByName returns the domain with the given name. It fails if there is no such domain, or if several domains have this name.
+func findDomainByName(ctx context.Context, client *sifflet.ClientWithResponses, name string, summary string) (sifflet.PublicGetDomainDto, diag.Diagnostics) {
+	domains, diags := listDomains(ctx, client, summary)
+	if diags.HasError() {
+		return sifflet.PublicGetDomainDto{}, diags
+	}
+
+	return tfutils.FindByName(domains, name, func(domain sifflet.PublicGetDomainDto) (string, error) {
+		return domain.Name, nil
+	}, "domain", summary)
+}
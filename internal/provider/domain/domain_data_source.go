@@ -3,21 +3,23 @@ package domain
 import (
 	"context"
 	"fmt"
-	"net/http"
 
 	"terraform-provider-sifflet/internal/apiclients"
 	sifflet "terraform-provider-sifflet/internal/client"
 	"terraform-provider-sifflet/internal/tfutils"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ datasource.DataSource              = &domainDataSource{}
-	_ datasource.DataSourceWithConfigure = &domainDataSource{}
+	_ datasource.DataSource                     = &domainDataSource{}
+	_ datasource.DataSourceWithConfigure        = &domainDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &domainDataSource{}
 )
 
 func newDomainDataSource() datasource.DataSource {
@@ -52,14 +54,16 @@ func (d *domainDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 
 func DomainDataSourceSchema(ctx context.Context) schema.Schema {
 	return schema.Schema{
-		Description: "Read a Sifflet domain by its ID. A domain represents a subset of assets. Domains are used to provide a view of specific business areas, and provide different access to different teams.",
+		Description: "Read a Sifflet domain by its ID or by its name. A domain represents a subset of assets. Domains are used to provide a view of specific business areas, and provide different access to different teams.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The ID of the domain.",
-				Required:    true,
+				Description: "The ID of the domain. Either id or name must be set.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the domain.",
+				Description: "The name of the domain. Either id or name must be set. The name must identify a single domain.",
+				Optional:    true,
 				Computed:    true,
 			},
 			"description": schema.StringAttribute{
@@ -74,6 +78,15 @@ func (d *domainDataSource) Schema(ctx context.Context, _ datasource.SchemaReques
 	resp.Schema = DomainDataSourceSchema(ctx)
 }
 
+func (d *domainDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
 type domainDataSourceModel struct {
 	Id          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
@@ -90,35 +103,36 @@ func (d *domainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	idStr := data.Id.ValueString()
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to parse domain ID",
-			err.Error(),
-		)
-		return
-	}
-
-	domainResponse, err := d.client.PublicGetDomainWithResponse(ctx, id)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to read domain",
-			err.Error(),
-		)
-		return
-	}
-
-	if domainResponse.StatusCode() != http.StatusOK {
-		sifflet.HandleHttpErrorAsProblem(
-			ctx, &resp.Diagnostics, "Unable to read domain",
-			domainResponse.StatusCode(), domainResponse.Body,
-		)
-		return
+	var domainDto sifflet.PublicGetDomainDto
+	if !data.Name.IsNull() {
+		dto, diags := findDomainByName(ctx, d.client, data.Name.ValueString(), "Unable to read domain")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		domainDto = dto
+	} else {
+		idStr := data.Id.ValueString()
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to parse domain ID",
+				err.Error(),
+			)
+			return
+		}
+
+		dto, diags := getDomain(ctx, d.client, id, "Unable to read domain")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		domainDto = dto
 	}
 
-	data.Name = types.StringValue(domainResponse.JSON200.Name)
-	data.Description = types.StringPointerValue(domainResponse.JSON200.Description)
+	data.Id = types.StringValue(domainDto.Id.String())
+	data.Name = types.StringValue(domainDto.Name)
+	data.Description = types.StringPointerValue(domainDto.Description)
 
 	diags := resp.State.Set(ctx, data)
 	resp.Diagnostics.Append(diags...)
@@ -33,6 +33,25 @@ func TestAccDomainDataSource(t *testing.T) {
 	})
 }
 
+func TestAccDomainDataSourceByName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// This domain exists on all Sifflet instances.
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_domain" "test" {
+					name = "All"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sifflet_domain.test", "id", "aaaabbbb-aaaa-bbbb-aaaa-bbbbaaaabbbb"),
+					resource.TestCheckResourceAttr("data.sifflet_domain.test", "description", "Global domain"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDomainDataSourceError(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
@@ -44,6 +63,21 @@ func TestAccDomainDataSourceError(t *testing.T) {
 				}`,
 				ExpectError: regexp.MustCompile("HTTP status code: 404. Details: Domain not found"),
 			},
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_domain" "test" {
+					name = "no domain has this name"
+				}`,
+				ExpectError: regexp.MustCompile("No domain named \"no domain has this name\" was found"),
+			},
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_domain" "test" {
+					id   = "aaaabbbb-aaaa-bbbb-aaaa-bbbbaaaabbbb"
+					name = "All"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
 		},
 	})
 }
@@ -9,6 +9,7 @@ import (
 	sifflet "terraform-provider-sifflet/internal/client"
 	"terraform-provider-sifflet/internal/tfutils"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -134,10 +135,37 @@ func domainResourceSchema() schema.Schema {
 									Validators: []validator.List{
 										listvalidator.ExactlyOneOf(
 											path.MatchRelative().AtParent().AtName("schema_uris"),
+											path.MatchRelative().AtParent().AtName("sources"),
 											path.MatchRelative(),
 										),
 									},
 								},
+								"sources": schema.ListNestedAttribute{
+									Description: "The sources to filter assets by in the dynamic condition. The sources are resolved to the schemas they contain when the domain is created or updated: " +
+										"the domain is a snapshot of the schemas of the sources at apply time, and schemas added to a source later are only included on the next update of the domain.",
+									Optional: true,
+									Validators: []validator.List{
+										listvalidator.SizeAtLeast(1),
+									},
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"id": schema.StringAttribute{
+												Description: "The ID of the source. If provided, name must be omitted.",
+												Optional:    true,
+												Validators: []validator.String{
+													stringvalidator.ExactlyOneOf(
+														path.MatchRelative().AtParent().AtName("name"),
+														path.MatchRelative(),
+													),
+												},
+											},
+											"name": schema.StringAttribute{
+												Description: "The name of the source. If provided, id must be omitted. The name must identify a single source.",
+												Optional:    true,
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -157,6 +185,26 @@ func domainResourceSchema() schema.Schema {
 					},
 				},
 			},
+			"team_permissions": schema.SetNestedAttribute{
+				Description: "Roles granted to teams in the domain. When set, this attribute is authoritative: teams granted access to the domain outside of Terraform lose their access on the next apply. " +
+					"When not set, the team permissions of the domain are not managed. Don't manage the permissions of a given team in this domain both here and in the domain_permissions of a sifflet_team resource.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"team_id": schema.StringAttribute{
+							Description: "Team ID.",
+							Required:    true,
+						},
+						"domain_role": schema.StringAttribute{
+							Description: "Team role in the domain. One of 'EDITOR', 'VIEWER', 'CATALOG_EDITOR', 'MONITOR_RESPONDER'.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("EDITOR", "VIEWER", "CATALOG_EDITOR", "MONITOR_RESPONDER"),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -187,7 +235,18 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	domainDto, diags := plan.ToCreateDto(ctx)
+	resolver := &sourceConditionsResolver{client: r.client, summary: "Unable to create domain"}
+	resolvedPlan, resolvedSources, diags := plan.resolveSourceConditions(ctx, resolver)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resolvedSources.checkNotEmpty("Unable to create domain")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainDto, diags := resolvedPlan.ToCreateDto(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -212,6 +271,31 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = newState.restoreSourceConditions(ctx, plan, resolvedSources)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setPrivateSourceConditions(ctx, resp.Private, resolvedSources)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ManagesTeamPermissions() {
+		newState.TeamPermissions = plan.TeamPermissions
+		// Save the domain in the state first, so that it's not lost if granting the team permissions fails
+		diags = resp.State.Set(ctx, newState)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		newState, diags = r.applyTeamPermissions(ctx, plan, domainModel{}, *domainResponse.JSON201, "Unable to grant team permissions on domain")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
@@ -220,6 +304,20 @@ func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 }
 
+// privateStateSetter is implemented by the private state of the resource responses.
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setPrivateSourceConditions keeps the resolved source conditions in the private state, for the next refreshes.
+func setPrivateSourceConditions(ctx context.Context, private privateStateSetter, resolved sourceConditions) diag.Diagnostics {
+	value, diags := resolved.toPrivate()
+	if diags.HasError() {
+		return diags
+	}
+	return private.SetKey(ctx, privateKeySourceConditions, value)
+}
+
 func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
 	defer cancel()
@@ -257,6 +355,43 @@ func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	if state.ManagesTeamPermissions() {
+		diags = newState.TeamPermissionsFromDto(ctx, *domainResponse.JSON200)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Keep the conditions on sources as long as the schema URIs of the domain are the ones the sources were resolved to on the last apply.
+	// The sources are not resolved again here: the domain is a snapshot of the schemas of the sources when it was applied.
+	privateSourceConditions, diags := req.Private.GetKey(ctx, privateKeySourceConditions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resolvedSources, diags := sourceConditionsFromPrivate(privateSourceConditions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if resolvedSources == nil {
+		resolvedSources, diags = newState.currentSourceConditions(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	diags = newState.restoreSourceConditions(ctx, state, resolvedSources)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setPrivateSourceConditions(ctx, resp.Private, resolvedSources)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -275,13 +410,31 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	var state domainModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	id, diags := plan.ModelId()
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	domainDto, diags := plan.ToUpdateDto(ctx)
+	resolver := &sourceConditionsResolver{client: r.client, summary: "Unable to update domain"}
+	resolvedPlan, resolvedSources, diags := plan.resolveSourceConditions(ctx, resolver)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resolvedSources.checkNotEmpty("Unable to update domain")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainDto, diags := resolvedPlan.ToUpdateDto(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -307,6 +460,24 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if plan.ManagesTeamPermissions() || state.ManagesTeamPermissions() {
+		newState, diags = r.applyTeamPermissions(ctx, plan, state, *domainResponse.JSON200, "Unable to update team permissions on domain")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = newState.restoreSourceConditions(ctx, plan, resolvedSources)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setPrivateSourceConditions(ctx, resp.Private, resolvedSources)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -314,6 +485,56 @@ func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 }
 
+// applyTeamPermissions updates the team permissions of a domain, and returns the new state read back from the API.
+// When the plan manages the team permissions, teams that are not listed lose their access to the domain.
+// Otherwise, only the teams listed in the prior state lose their access.
+func (r *domainResource) applyTeamPermissions(ctx context.Context, plan domainModel, prior domainModel, domainDto sifflet.PublicGetDomainDto, summary string) (domainModel, diag.Diagnostics) {
+	current := []sifflet.PublicDomainTeamPermissionDto{}
+	if domainDto.TeamPermissions != nil {
+		current = *domainDto.TeamPermissions
+	}
+
+	wanted, diags := plan.getTeamPermissionsDto(ctx)
+	if diags.HasError() {
+		return domainModel{}, diags
+	}
+
+	var revoked []uuid.UUID
+	if plan.ManagesTeamPermissions() {
+		for _, permission := range current {
+			revoked = append(revoked, permission.TeamId)
+		}
+	} else {
+		revoked, diags = prior.getTeamIds(ctx)
+		if diags.HasError() {
+			return domainModel{}, diags
+		}
+	}
+
+	diags = updateTeamPermissions(ctx, r.client, domainDto.Id, current, wanted, revoked, summary)
+	if diags.HasError() {
+		return domainModel{}, diags
+	}
+
+	updatedDomainDto, diags := getDomain(ctx, r.client, domainDto.Id, summary)
+	if diags.HasError() {
+		return domainModel{}, diags
+	}
+
+	var newState domainModel
+	diags = newState.FromDto(ctx, updatedDomainDto)
+	if diags.HasError() {
+		return domainModel{}, diags
+	}
+	if plan.ManagesTeamPermissions() {
+		diags = newState.TeamPermissionsFromDto(ctx, updatedDomainDto)
+		if diags.HasError() {
+			return domainModel{}, diags
+		}
+	}
+	return newState, diag.Diagnostics{}
+}
+
 func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	ctx, cancel := tfutils.WithDefaultDeleteTimeout(ctx)
 	defer cancel()
@@ -419,6 +640,7 @@ func upgradeDynamicContentDefinitionV0ToV1(ctx context.Context, dynamicV0Obj typ
 			LogicalOperator: conditionV0.LogicalOperator,
 			SchemaUris:      schemaUrisUpgraded,
 			Tags:            conditionV0.Tags,
+			Sources:         types.ListNull(types.ObjectType{AttrTypes: sourceReferenceModel{}.AttributeTypes()}),
 		}
 
 		return types.ObjectValueFrom(ctx, upgradedCondition.AttributeTypes(), upgradedCondition)
@@ -456,6 +678,8 @@ func (r *domainResource) UpgradeState(ctx context.Context) map[int64]resource.St
 	if !ok {
 		panic("conditions is not a ListNestedAttribute in the current domain resource schema (this is a bug in the provider)")
 	}
+	// sources was added after v1, without a schema version change
+	delete(conditions.NestedObject.Attributes, "sources")
 	conditions.NestedObject.Attributes["schema_uris"] = schema.ListAttribute{
 		Description: "The source schemas to filter assets by in the dynamic condition, in URI format.",
 		Optional:    true,
@@ -463,6 +687,8 @@ func (r *domainResource) UpgradeState(ctx context.Context) map[int64]resource.St
 	}
 	dynamicContentDef.Attributes["conditions"] = conditions
 	v0Schema.Attributes["dynamic_content_definition"] = dynamicContentDef
+	// team_permissions was added after v1, without a schema version change
+	delete(v0Schema.Attributes, "team_permissions")
 
 	return map[int64]resource.StateUpgrader{
 		0: {
@@ -487,6 +713,7 @@ func (r *domainResource) UpgradeState(ctx context.Context) map[int64]resource.St
 					Name:                    priorState.Name,
 					Description:             priorState.Description,
 					StaticContentDefinition: priorState.StaticContentDefinition,
+					TeamPermissions:         types.SetNull(types.ObjectType{AttrTypes: teamPermissionModel{}.AttributeTypes()}),
 				}
 
 				if !priorState.DynamicContentDefinition.IsNull() && !priorState.DynamicContentDefinition.IsUnknown() {
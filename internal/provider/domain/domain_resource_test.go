@@ -363,3 +363,178 @@ func TestAccDomainDynamicContentDefinitionResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDomainTeamPermissionsResource(t *testing.T) {
+	ctx := t.Context()
+	client, err := providertests.ClientForTests(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	workspaceName := providertests.RandomName()
+	assetUri := providertests.RandomGithubDeclaredAssetUri()
+	assetDescription := "Created by Terraform provider tests"
+	assetName := providertests.SessionPrefix() + " " + assetUri
+	subTypeName := "TerraformTest"
+
+	domainName := providertests.RandomName()
+	teamName := providertests.RandomName()
+
+	config := func(teamPermissions string) string {
+		return providertests.ProviderConfig() + fmt.Sprintf(`
+		data "sifflet_asset" "test" {
+			uri = "%s"
+		}
+
+		resource "sifflet_team" "test" {
+			name = "%s"
+		}
+
+		resource "sifflet_domain" "test" {
+			name = "%s"
+			static_content_definition = {
+				asset_uris = [data.sifflet_asset.test.uri]
+			}
+			%s
+		}
+		`, assetUri, teamName, domainName, teamPermissions)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			// Create the declared assets
+			asset := sifflet.PublicDeclarativeAssetDto{
+				Uri:         assetUri,
+				Description: &assetDescription,
+				Name:        &assetName,
+				Type:        sifflet.Generic,
+				SubType:     &subTypeName,
+			}
+			err := providertests.CreateDeclaredAssets(ctx, client, workspaceName, &[]sifflet.PublicDeclarativeAssetDto{asset})
+			if err != nil {
+				t.Fatalf("Failed to create declared assets: %v", err)
+			}
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+				team_permissions = [{
+					team_id = sifflet_team.test.id
+					domain_role = "VIEWER"
+				}]
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_domain.test", "team_permissions.#", "1"),
+					resource.TestCheckResourceAttrPair("sifflet_domain.test", "team_permissions.0.team_id", "sifflet_team.test", "id"),
+					resource.TestCheckResourceAttr("sifflet_domain.test", "team_permissions.0.domain_role", "VIEWER"),
+				),
+			},
+			{
+				ResourceName:            "sifflet_domain.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"team_permissions"},
+			},
+			{
+				Config: config(`
+				team_permissions = [{
+					team_id = sifflet_team.test.id
+					domain_role = "EDITOR"
+				}]
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_domain.test", "team_permissions.#", "1"),
+					resource.TestCheckResourceAttr("sifflet_domain.test", "team_permissions.0.domain_role", "EDITOR"),
+				),
+			},
+			{
+				// The team loses its access to the domain
+				Config: config(`team_permissions = []`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_domain.test", "team_permissions.#", "0"),
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			// Delete the declared assets and all related resources
+			err := providertests.DeleteDeclaredAssets(ctx, client, workspaceName)
+			return err
+		},
+	})
+}
+
+func TestAccDomainSourcesCondition(t *testing.T) {
+	domainName := providertests.RandomName()
+	sourceName := providertests.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Exactly one of id or name must be provided
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+				resource "sifflet_domain" "test" {
+					name = "%s"
+					dynamic_content_definition = {
+						logical_operator = "AND"
+						conditions = [{
+							logical_operator = "IS"
+							sources = [{
+								id = "00000000-0000-0000-0000-000000000000"
+								name = "%s"
+							}]
+						}]
+					}
+				}
+				`, domainName, sourceName),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+				resource "sifflet_domain" "test" {
+					name = "%s"
+					dynamic_content_definition = {
+						logical_operator = "AND"
+						conditions = [{
+							logical_operator = "IS"
+							sources = [{
+								name = "%s"
+							}]
+						}]
+					}
+				}
+				`, domainName, sourceName),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("No source named %q was found", sourceName)),
+			},
+			{
+				// A source that was never ingested doesn't contain any schema
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+				resource "sifflet_source_v2" "test" {
+					name = "%s"
+					parameters = {
+						dbt = {
+							target = "target"
+							project_name = "%s"
+						}
+					}
+				}
+
+				resource "sifflet_domain" "test" {
+					name = "%s"
+					dynamic_content_definition = {
+						logical_operator = "AND"
+						conditions = [{
+							logical_operator = "IS"
+							sources = [{
+								name = sifflet_source_v2.test.name
+							}]
+						}]
+					}
+				}
+				`, sourceName, sourceName, domainName),
+				ExpectError: regexp.MustCompile("don't contain any schema yet"),
+			},
+		},
+	})
+}
@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ datasource.DataSource              = &domainsDataSource{}
+	_ datasource.DataSourceWithConfigure = &domainsDataSource{}
+)
+
+func newDomainsDataSource() datasource.DataSource {
+	return &domainsDataSource{}
+}
+
+type domainsDataSource struct {
+	client *sifflet.ClientWithResponses
+}
+
+func (d *domainsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Client
+}
+
+func (d *domainsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+func DomainsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Return the domains matching search criteria.",
+		Attributes: map[string]schema.Attribute{
+			"max_results": schema.Int32Attribute{
+				Description: "Maximum number of results to return. Default is 1000.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Search criteria. Domains must match all the criteria that are set.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"text_search": schema.StringAttribute{
+						Description: "Return domains whose name contains this text (case-insensitive).",
+						Optional:    true,
+					},
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "List of domains returned by the search.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Domain ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Domain name.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Domain description.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *domainsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DomainsDataSourceSchema(ctx)
+}
+
+type domainsDataSourceModel struct {
+	MaxResults types.Int32  `tfsdk:"max_results"`
+	Filter     types.Object `tfsdk:"filter"`
+	Results    types.List   `tfsdk:"results"`
+}
+
+type domainsFilterModel struct {
+	TextSearch types.String `tfsdk:"text_search"`
+}
+
+type domainSummaryModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (m domainSummaryModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+	}
+}
+
+func (m *domainSummaryModel) FromDto(domain sifflet.PublicGetDomainDto) {
+	m.Id = types.StringValue(domain.Id.String())
+	m.Name = types.StringValue(domain.Name)
+	m.Description = types.StringPointerValue(domain.Description)
+}
+
+// matches returns true if the domain matches all the criteria of the filter.
+func (m domainsFilterModel) matches(domain sifflet.PublicGetDomainDto) bool {
+	if !m.TextSearch.IsNull() && !strings.Contains(strings.ToLower(domain.Name), strings.ToLower(m.TextSearch.ValueString())) {
+		return false
+	}
+	return true
+}
+
+func (d *domainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var data domainsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := domainsFilterModel{
+		TextSearch: types.StringNull(),
+	}
+	if !data.Filter.IsNull() {
+		diags = data.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	maxResults := int(data.MaxResults.ValueInt32())
+	if maxResults == 0 {
+		// Set a default
+		maxResults = 1000
+	}
+
+	// The API doesn't support filtering domains: filters are applied here.
+	domains, diags := listDomains(ctx, d.client, "Unable to list domains")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results := make([]domainSummaryModel, 0)
+	for _, domain := range domains {
+		if len(results) >= maxResults {
+			break
+		}
+		if !filter.matches(domain) {
+			continue
+		}
+
+		var summary domainSummaryModel
+		summary.FromDto(domain)
+		results = append(results, summary)
+	}
+
+	data.Results, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: domainSummaryModel{}.AttributeTypes()}, results)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
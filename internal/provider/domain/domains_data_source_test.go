@@ -0,0 +1,43 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+)
+
+func TestAccDomainsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_domains" "all" {
+				}
+
+				data "sifflet_domains" "limited" {
+					max_results = 1
+				}
+
+				data "sifflet_domains" "no_match" {
+					filter = {
+						text_search = "no domain has this name"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.sifflet_domains.all", "results.*", map[string]string{
+						// This domain exists on all Sifflet instances.
+						"id":          "aaaabbbb-aaaa-bbbb-aaaa-bbbbaaaabbbb",
+						"name":        "All",
+						"description": "Global domain",
+					}),
+					resource.TestCheckResourceAttr("data.sifflet_domains.limited", "results.#", "1"),
+					resource.TestCheckResourceAttr("data.sifflet_domains.no_match", "results.#", "0"),
+				),
+			},
+		},
+	})
+}
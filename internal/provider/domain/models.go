@@ -29,6 +29,12 @@ type dynamicContentDefinitionConditionModel struct {
 	LogicalOperator types.String `tfsdk:"logical_operator"`
 	SchemaUris      types.Set    `tfsdk:"schema_uris"`
 	Tags            types.List   `tfsdk:"tags"`
+	Sources         types.List   `tfsdk:"sources"`
+}
+
+type sourceReferenceModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
 }
 
 type dynamicContentDefinitionModel struct {
@@ -42,9 +48,16 @@ type domainModel struct {
 	Description              types.String `tfsdk:"description"`
 	DynamicContentDefinition types.Object `tfsdk:"dynamic_content_definition"`
 	StaticContentDefinition  types.Object `tfsdk:"static_content_definition"`
+	TeamPermissions          types.Set    `tfsdk:"team_permissions"`
+}
+
+type teamPermissionModel struct {
+	TeamId     types.String `tfsdk:"team_id"`
+	DomainRole types.String `tfsdk:"domain_role"`
 }
 
 var (
+	_ model.InnerModel[sifflet.PublicDomainTeamPermissionDto]                                                   = &teamPermissionModel{}
 	_ model.FullModel[sifflet.PublicGetDomainDto, sifflet.PublicCreateDomainDto, sifflet.PublicUpdateDomainDto] = &domainModel{}
 	_ model.ModelWithId[uuid.UUID]                                                                              = &domainModel{}
 )
@@ -121,6 +134,45 @@ func (m domainModel) HasStaticContentDefinition() bool {
 	return !m.StaticContentDefinition.IsNull() && !m.StaticContentDefinition.IsUnknown()
 }
 
+// ManagesTeamPermissions returns whether the team permissions of the domain are managed by the resource.
+func (m domainModel) ManagesTeamPermissions() bool {
+	return !m.TeamPermissions.IsNull() && !m.TeamPermissions.IsUnknown()
+}
+
+func (m domainModel) getTeamPermissionsDto(ctx context.Context) ([]sifflet.PublicDomainTeamPermissionDto, diag.Diagnostics) {
+	if !m.ManagesTeamPermissions() {
+		return []sifflet.PublicDomainTeamPermissionDto{}, diag.Diagnostics{}
+	}
+
+	permissionsModel := make([]teamPermissionModel, 0, len(m.TeamPermissions.Elements()))
+	diags := m.TeamPermissions.ElementsAs(ctx, &permissionsModel, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	permissions := make([]sifflet.PublicDomainTeamPermissionDto, 0, len(permissionsModel))
+	for _, permissionModel := range permissionsModel {
+		permission, diags := permissionModel.ToDto(ctx)
+		if diags.HasError() {
+			return nil, diags
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions, diag.Diagnostics{}
+}
+
+// getTeamIds returns the IDs of the teams listed in the team permissions.
+func (m domainModel) getTeamIds(ctx context.Context) ([]uuid.UUID, diag.Diagnostics) {
+	permissions, diags := m.getTeamPermissionsDto(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	teamIds := make([]uuid.UUID, 0, len(permissions))
+	for _, permission := range permissions {
+		teamIds = append(teamIds, permission.TeamId)
+	}
+	return teamIds, diag.Diagnostics{}
+}
+
 func (m domainModel) ToCreateDto(ctx context.Context) (sifflet.PublicCreateDomainDto, diag.Diagnostics) {
 	var assetContentDefinition sifflet.PublicCreateDomainDto_AssetContentDefinition
 
@@ -257,10 +309,54 @@ func (m *domainModel) FromDto(ctx context.Context, dto sifflet.PublicGetDomainDt
 	m.Id = types.StringValue(dto.Id.String())
 	m.Name = types.StringValue(dto.Name)
 	m.Description = types.StringPointerValue(dto.Description)
+	// Team permissions are only read when they are managed by the resource, see TeamPermissionsFromDto.
+	m.TeamPermissions = types.SetNull(types.ObjectType{AttrTypes: teamPermissionModel{}.AttributeTypes()})
 
 	return diag.Diagnostics{}
 }
 
+// TeamPermissionsFromDto reads the team permissions of the domain.
+func (m *domainModel) TeamPermissionsFromDto(ctx context.Context, dto sifflet.PublicGetDomainDto) diag.Diagnostics {
+	permissionsDto := []sifflet.PublicDomainTeamPermissionDto{}
+	if dto.TeamPermissions != nil {
+		permissionsDto = *dto.TeamPermissions
+	}
+	permissions, diags := model.NewModelSetFromDto(
+		ctx, permissionsDto,
+		func() model.InnerModel[sifflet.PublicDomainTeamPermissionDto] { return &teamPermissionModel{} },
+	)
+	if diags.HasError() {
+		return diags
+	}
+	m.TeamPermissions = permissions
+	return diag.Diagnostics{}
+}
+
+// Team permission model.
+func (m teamPermissionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"team_id":     types.StringType,
+		"domain_role": types.StringType,
+	}
+}
+
+func (m *teamPermissionModel) FromDto(_ context.Context, dto sifflet.PublicDomainTeamPermissionDto) diag.Diagnostics {
+	m.TeamId = types.StringValue(dto.TeamId.String())
+	m.DomainRole = types.StringValue(string(dto.DomainRole))
+	return diag.Diagnostics{}
+}
+
+func (m teamPermissionModel) ToDto(_ context.Context) (sifflet.PublicDomainTeamPermissionDto, diag.Diagnostics) {
+	teamId, err := uuid.Parse(m.TeamId.ValueString())
+	if err != nil {
+		return sifflet.PublicDomainTeamPermissionDto{}, tfutils.ErrToDiags("Could not parse team ID as UUID", err)
+	}
+	return sifflet.PublicDomainTeamPermissionDto{
+		TeamId:     teamId,
+		DomainRole: sifflet.PublicDomainTeamPermissionDtoDomainRole(m.DomainRole.ValueString()),
+	}, diag.Diagnostics{}
+}
+
 // Static content definition model.
 func (m staticContentDefinitionModel) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
@@ -321,6 +417,9 @@ func (m dynamicContentDefinitionConditionModel) AttributeTypes() map[string]attr
 		"tags": types.ListType{
 			ElemType: types.ObjectType{AttrTypes: tagModel{}.AttributeTypes()},
 		},
+		"sources": types.ListType{
+			ElemType: types.ObjectType{AttrTypes: sourceReferenceModel{}.AttributeTypes()},
+		},
 	}
 }
 
@@ -346,6 +445,8 @@ func (m *dynamicContentDefinitionConditionModel) FromDto(ctx context.Context, dt
 		m.LogicalOperator = types.StringValue(string(*sourceFilterCondition.Operator))
 		m.SchemaUris = schemaUris
 		m.Tags = types.ListNull(types.ObjectType{AttrTypes: tagModel{}.AttributeTypes()})
+		// The API only returns schema URIs, see domainModel.restoreSourceConditions
+		m.Sources = types.ListNull(types.ObjectType{AttrTypes: sourceReferenceModel{}.AttributeTypes()})
 	} else if conditionType == string(sifflet.PublicFilterDomainConditionDtoTypeTAG) {
 		tagFilterCondition, err := dto.AsPublicTagFilterDomainConditionDto()
 		if err != nil {
@@ -363,6 +464,7 @@ func (m *dynamicContentDefinitionConditionModel) FromDto(ctx context.Context, dt
 		m.LogicalOperator = types.StringValue(string(*tagFilterCondition.Operator))
 		m.Tags = tags
 		m.SchemaUris = types.SetNull(types.StringType)
+		m.Sources = types.ListNull(types.ObjectType{AttrTypes: sourceReferenceModel{}.AttributeTypes()})
 	} else {
 		return diag.Diagnostics{
 			diag.NewErrorDiagnostic("Unable to read domain condition", fmt.Sprintf("No source or tag filter condition found, got condition type: %s", conditionType)),
@@ -439,6 +541,14 @@ func (m dynamicContentDefinitionConditionModel) ToDto(ctx context.Context) (siff
 	return conditionDto, diag.Diagnostics{}
 }
 
+// Source reference model.
+func (m sourceReferenceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	}
+}
+
 // Tag model.
 func (m tagModel) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
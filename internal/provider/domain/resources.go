@@ -14,5 +14,6 @@ func Resources() []func() resource.Resource {
 func DataSources() []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newDomainDataSource,
+		newDomainsDataSource,
 	}
 }
@@ -0,0 +1,286 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/provider/source_v2"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// The API filters domain assets by schema URIs only. Conditions on sources are resolved by the provider
+// to the URIs of all the schemas contained in the sources when the domain is created or updated. The
+// resolved URIs are kept in the private state, and the conditions on sources are restored in the state
+// as long as the URIs returned by the API still match them.
+
+// Private state key storing the schema URIs that the conditions on sources were resolved to on the last apply.
+const privateKeySourceConditions = "source_conditions"
+
+// sourceConditionsResolver resolves source references to schema URIs. Sources are listed at most once.
+type sourceConditionsResolver struct {
+	client  *sifflet.ClientWithResponses
+	summary string
+	sources []sifflet.SiffletPublicGetSourceV2Dto
+}
+
+func (r *sourceConditionsResolver) findSourceIdByName(ctx context.Context, name string) (uuid.UUID, diag.Diagnostics) {
+	if r.sources == nil {
+		sources, diags := source_v2.ListSources(ctx, r.client, r.summary)
+		if diags.HasError() {
+			return uuid.Nil, diags
+		}
+		r.sources = sources
+	}
+
+	source, diags := source_v2.FindSourceByName(r.sources, name, r.summary)
+	if diags.HasError() {
+		return uuid.Nil, diags
+	}
+	sourceDto, err := source.GetSourceDto()
+	if err != nil {
+		diags.AddError(r.summary, err.Error())
+		return uuid.Nil, diags
+	}
+	return sourceDto.GetId(), diags
+}
+
+func (r *sourceConditionsResolver) getSchemaUris(ctx context.Context, id uuid.UUID) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schemasResponse, err := r.client.PublicGetSourceSchemaListWithResponse(ctx, id)
+	if err != nil {
+		diags.AddError(r.summary, err.Error())
+		return nil, diags
+	}
+	if schemasResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, r.summary, schemasResponse.StatusCode(), schemasResponse.Body)
+		return nil, diags
+	}
+
+	uris := make([]string, 0, len(schemasResponse.JSON200.Schemas))
+	for _, schema := range schemasResponse.JSON200.Schemas {
+		uris = append(uris, schema.Uri)
+	}
+	return uris, diags
+}
+
+// resolve returns the URIs of the schemas contained in the referenced sources.
+func (r *sourceConditionsResolver) resolve(ctx context.Context, sources types.List) ([]string, diag.Diagnostics) {
+	var references []sourceReferenceModel
+	diags := sources.ElementsAs(ctx, &references, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	uris := make([]string, 0)
+	for _, reference := range references {
+		var id uuid.UUID
+		if !reference.Id.IsNull() {
+			parsedId, err := uuid.Parse(reference.Id.ValueString())
+			if err != nil {
+				diags.AddError(r.summary, fmt.Sprintf("Could not parse source ID %q as UUID: %s", reference.Id.ValueString(), err))
+				return nil, diags
+			}
+			id = parsedId
+		} else {
+			id, diags = r.findSourceIdByName(ctx, reference.Name.ValueString())
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		sourceUris, diags := r.getSchemaUris(ctx, id)
+		if diags.HasError() {
+			return nil, diags
+		}
+		uris = append(uris, sourceUris...)
+	}
+
+	slices.Sort(uris)
+	return slices.Compact(uris), diags
+}
+
+// sourceConditions maps the index of each condition that references sources to the schema URIs of these sources.
+type sourceConditions map[int][]string
+
+// toPrivate returns the value stored in the private state for the resolved source conditions.
+func (c sourceConditions) toPrivate() ([]byte, diag.Diagnostics) {
+	value, err := json.Marshal(c)
+	if err != nil {
+		return nil, tfutils.ErrToDiags("Unable to save the sources of the domain conditions", err)
+	}
+	return value, diag.Diagnostics{}
+}
+
+// sourceConditionsFromPrivate reads the resolved source conditions stored in the private state.
+// It returns nil if the private state doesn't contain them.
+func sourceConditionsFromPrivate(value []byte) (sourceConditions, diag.Diagnostics) {
+	if value == nil {
+		return nil, diag.Diagnostics{}
+	}
+	var resolved sourceConditions
+	if err := json.Unmarshal(value, &resolved); err != nil {
+		return nil, tfutils.ErrToDiags("Unable to read the sources of the domain conditions", err)
+	}
+	return resolved, diag.Diagnostics{}
+}
+
+// checkNotEmpty fails if the sources of a condition don't contain any schema, since the condition would then match no asset.
+func (c sourceConditions) checkNotEmpty(summary string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, uris := range c {
+		if len(uris) == 0 {
+			diags.AddError(summary, fmt.Sprintf("The sources of condition %d don't contain any schema yet. Run an ingestion of the sources first.", i))
+		}
+	}
+	return diags
+}
+
+// conditionsModel returns the conditions of the dynamic content definition. The list is empty if the domain has no dynamic content definition.
+func (m domainModel) conditionsModel(ctx context.Context) ([]dynamicContentDefinitionConditionModel, diag.Diagnostics) {
+	if !m.HasDynamicContentDefinition() {
+		return []dynamicContentDefinitionConditionModel{}, diag.Diagnostics{}
+	}
+
+	var dynamicContentDefinition dynamicContentDefinitionModel
+	diags := m.DynamicContentDefinition.As(ctx, &dynamicContentDefinition, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	conditions := make([]dynamicContentDefinitionConditionModel, 0, len(dynamicContentDefinition.Conditions.Elements()))
+	diags = dynamicContentDefinition.Conditions.ElementsAs(ctx, &conditions, false)
+	return conditions, diags
+}
+
+// withConditions returns a copy of the domain with the given conditions in its dynamic content definition.
+func (m domainModel) withConditions(ctx context.Context, conditions []dynamicContentDefinitionConditionModel) (domainModel, diag.Diagnostics) {
+	var dynamicContentDefinition dynamicContentDefinitionModel
+	diags := m.DynamicContentDefinition.As(ctx, &dynamicContentDefinition, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return domainModel{}, diags
+	}
+
+	conditionsList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dynamicContentDefinitionConditionModel{}.AttributeTypes()}, conditions)
+	if diags.HasError() {
+		return domainModel{}, diags
+	}
+	dynamicContentDefinition.Conditions = conditionsList
+
+	dynamicContentDefinitionObject, diags := types.ObjectValueFrom(ctx, dynamicContentDefinition.AttributeTypes(), dynamicContentDefinition)
+	if diags.HasError() {
+		return domainModel{}, diags
+	}
+	m.DynamicContentDefinition = dynamicContentDefinitionObject
+	return m, diag.Diagnostics{}
+}
+
+// resolveSourceConditions returns the schema URIs of the conditions that reference sources, and a copy of the domain
+// where these conditions are replaced by conditions on schema URIs, ready to be sent to the API.
+func (m domainModel) resolveSourceConditions(ctx context.Context, resolver *sourceConditionsResolver) (domainModel, sourceConditions, diag.Diagnostics) {
+	resolved := sourceConditions{}
+
+	conditions, diags := m.conditionsModel(ctx)
+	if diags.HasError() {
+		return domainModel{}, nil, diags
+	}
+	hasSources := false
+	for i, condition := range conditions {
+		if condition.Sources.IsNull() || condition.Sources.IsUnknown() {
+			continue
+		}
+		hasSources = true
+
+		uris, diags := resolver.resolve(ctx, condition.Sources)
+		if diags.HasError() {
+			return domainModel{}, nil, diags
+		}
+		resolved[i] = uris
+
+		schemaUris, diags := types.SetValueFrom(ctx, types.StringType, uris)
+		if diags.HasError() {
+			return domainModel{}, nil, diags
+		}
+		conditions[i].SchemaUris = schemaUris
+		conditions[i].Sources = types.ListNull(types.ObjectType{AttrTypes: sourceReferenceModel{}.AttributeTypes()})
+	}
+	if !hasSources {
+		return m, resolved, diag.Diagnostics{}
+	}
+
+	resolvedModel, diags := m.withConditions(ctx, conditions)
+	return resolvedModel, resolved, diags
+}
+
+// currentSourceConditions returns the schema URIs of the conditions of the domain at the indexes of the conditions of prior that reference sources.
+// It's used for the states written before the resolved source conditions were kept in the private state, assuming that the sources didn't change since.
+func (m domainModel) currentSourceConditions(ctx context.Context, prior domainModel) (sourceConditions, diag.Diagnostics) {
+	resolved := sourceConditions{}
+
+	priorConditions, diags := prior.conditionsModel(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	conditions, diags := m.conditionsModel(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for i, priorCondition := range priorConditions {
+		if priorCondition.Sources.IsNull() || i >= len(conditions) || conditions[i].SchemaUris.IsNull() {
+			continue
+		}
+		uris := make([]string, 0, len(conditions[i].SchemaUris.Elements()))
+		diags = conditions[i].SchemaUris.ElementsAs(ctx, &uris, false)
+		if diags.HasError() {
+			return nil, diags
+		}
+		slices.Sort(uris)
+		resolved[i] = uris
+	}
+	return resolved, diag.Diagnostics{}
+}
+
+// restoreSourceConditions replaces the conditions read from the API by the matching conditions of config that reference sources,
+// when the schema URIs read from the API are still the schema URIs of these sources.
+func (m *domainModel) restoreSourceConditions(ctx context.Context, config domainModel, resolved sourceConditions) diag.Diagnostics {
+	if len(resolved) == 0 || !m.HasDynamicContentDefinition() {
+		return diag.Diagnostics{}
+	}
+
+	conditions, diags := m.conditionsModel(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	configConditions, diags := config.conditionsModel(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, uris := range resolved {
+		if i >= len(conditions) || i >= len(configConditions) {
+			continue
+		}
+		schemaUris, diags := types.SetValueFrom(ctx, types.StringType, uris)
+		if diags.HasError() {
+			return diags
+		}
+		if conditions[i].SchemaUris.Equal(schemaUris) && conditions[i].LogicalOperator.Equal(configConditions[i].LogicalOperator) {
+			conditions[i] = configConditions[i]
+		}
+	}
+
+	newModel, diags := m.withConditions(ctx, conditions)
+	if diags.HasError() {
+		return diags
+	}
+	*m = newModel
+	return diag.Diagnostics{}
+}
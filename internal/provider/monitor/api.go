@@ -0,0 +1,90 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// runMonitor starts a run of a monitor, and returns the pending run.
+func runMonitor(ctx context.Context, client *sifflet.ClientWithResponses, id uuid.UUID, summary string) (sifflet.RuleRunDto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	runResponse, err := client.SiffletRuleManualRunWithResponse(ctx, id)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return sifflet.RuleRunDto{}, diags
+	}
+
+	if runResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, runResponse.StatusCode(), runResponse.Body)
+		return sifflet.RuleRunDto{}, diags
+	}
+
+	return *runResponse.JSON200, diags
+}
+
+// getMonitorRun reads a run of a monitor.
+func getMonitorRun(ctx context.Context, client *sifflet.ClientWithResponses, id uuid.UUID, runId uuid.UUID, summary string) (sifflet.RuleRunDto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	runResponse, err := client.GetSiffletRuleRunWithResponse(ctx, id, runId, nil)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return sifflet.RuleRunDto{}, diags
+	}
+
+	if runResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, runResponse.StatusCode(), runResponse.Body)
+		return sifflet.RuleRunDto{}, diags
+	}
+
+	return *runResponse.JSON200, diags
+}
+
+// monitorRunStatus returns the status of a monitor run, or PENDING if the API didn't return any status.
+func monitorRunStatus(run sifflet.RuleRunDto) sifflet.RuleRunDtoStatus {
+	if run.Status == nil {
+		return sifflet.RuleRunDtoStatusPENDING
+	}
+	return *run.Status
+}
+
+// monitorRunCompleted returns true if the run is not pending or running anymore.
+func monitorRunCompleted(run sifflet.RuleRunDto) bool {
+	status := monitorRunStatus(run)
+	return status != sifflet.RuleRunDtoStatusPENDING && status != sifflet.RuleRunDtoStatusRUNNING
+}
+
+// monitorRunHasOpenIncident returns true if the run is attached to an incident that isn't closed yet.
+func monitorRunHasOpenIncident(run sifflet.RuleRunDto) bool {
+	if run.IncidentStatus == nil {
+		return false
+	}
+	return *run.IncidentStatus == sifflet.RuleRunDtoIncidentStatusOPEN || *run.IncidentStatus == sifflet.RuleRunDtoIncidentStatusINPROGRESS
+}
+
+// describeMonitorRun returns a human-readable description of a monitor run, including its debug SQL query when available.
+func describeMonitorRun(run sifflet.RuleRunDto) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Run ID: %s\nStatus: %s", run.Id, monitorRunStatus(run))
+	if run.Result != nil && *run.Result != "" {
+		fmt.Fprintf(&b, "\nResult: %s", *run.Result)
+	}
+	if run.IncidentName != nil {
+		fmt.Fprintf(&b, "\nIncident: %s", *run.IncidentName)
+		if run.IncidentStatus != nil {
+			fmt.Fprintf(&b, " (%s)", *run.IncidentStatus)
+		}
+	}
+	if run.DebugSql != nil && run.DebugSql.Query != "" {
+		fmt.Fprintf(&b, "\nDebug SQL:\n%s", run.DebugSql.Query)
+	}
+	return b.String()
+}
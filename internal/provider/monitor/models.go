@@ -0,0 +1,328 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/model"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parseOptionalUuid parses a UUID from a string attribute. A null or empty attribute is returned as nil.
+func parseOptionalUuid(s types.String) (*uuid.UUID, diag.Diagnostics) {
+	if s.IsNull() || s.ValueString() == "" {
+		return nil, diag.Diagnostics{}
+	}
+	id, err := uuid.Parse(s.ValueString())
+	if err != nil {
+		return nil, tfutils.ErrToDiags("Could not parse ID as UUID", err)
+	}
+	return &id, diag.Diagnostics{}
+}
+
+// uuidPointerValue converts an optional UUID to a string attribute.
+func uuidPointerValue(id *uuid.UUID) types.String {
+	if id == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(id.String())
+}
+
+type monitorDatasetModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Uri  types.String `tfsdk:"uri"`
+}
+
+var (
+	_ model.InnerModel[sifflet.AsCodeDatasetReferenceDto] = &monitorDatasetModel{}
+)
+
+func (m monitorDatasetModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+		"uri":  types.StringType,
+	}
+}
+
+func (m monitorDatasetModel) ToDto(_ context.Context) (sifflet.AsCodeDatasetReferenceDto, diag.Diagnostics) {
+	id, diags := parseOptionalUuid(m.Id)
+	if diags.HasError() {
+		return sifflet.AsCodeDatasetReferenceDto{}, diags
+	}
+	return sifflet.AsCodeDatasetReferenceDto{
+		Id:   id,
+		Name: m.Name.ValueStringPointer(),
+		Uri:  m.Uri.ValueStringPointer(),
+	}, diag.Diagnostics{}
+}
+
+func (m *monitorDatasetModel) FromDto(_ context.Context, dto sifflet.AsCodeDatasetReferenceDto) diag.Diagnostics {
+	m.Id = uuidPointerValue(dto.Id)
+	m.Name = types.StringPointerValue(dto.Name)
+	m.Uri = types.StringPointerValue(dto.Uri)
+	return diag.Diagnostics{}
+}
+
+type monitorTagModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Kind types.String `tfsdk:"kind"`
+}
+
+var (
+	_ model.InnerModel[sifflet.AsCodeTagReferenceDto] = &monitorTagModel{}
+)
+
+func (m monitorTagModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+		"kind": types.StringType,
+	}
+}
+
+func (m monitorTagModel) ToDto(_ context.Context) (sifflet.AsCodeTagReferenceDto, diag.Diagnostics) {
+	id, diags := parseOptionalUuid(m.Id)
+	if diags.HasError() {
+		return sifflet.AsCodeTagReferenceDto{}, diags
+	}
+	var kind *sifflet.AsCodeTagReferenceDtoKind
+	if !m.Kind.IsNull() {
+		k := sifflet.AsCodeTagReferenceDtoKind(m.Kind.ValueString())
+		kind = &k
+	}
+	return sifflet.AsCodeTagReferenceDto{
+		Id:   id,
+		Name: m.Name.ValueStringPointer(),
+		Kind: kind,
+	}, diag.Diagnostics{}
+}
+
+func (m *monitorTagModel) FromDto(_ context.Context, dto sifflet.AsCodeTagReferenceDto) diag.Diagnostics {
+	m.Id = uuidPointerValue(dto.Id)
+	m.Name = types.StringPointerValue(dto.Name)
+	if dto.Kind != nil {
+		m.Kind = types.StringValue(string(*dto.Kind))
+	} else {
+		m.Kind = types.StringNull()
+	}
+	return diag.Diagnostics{}
+}
+
+type monitorTermModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+var (
+	_ model.InnerModel[sifflet.AsCodeReferenceByIdOrNameDtoImpl] = &monitorTermModel{}
+)
+
+func (m monitorTermModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	}
+}
+
+func (m monitorTermModel) ToDto(_ context.Context) (sifflet.AsCodeReferenceByIdOrNameDtoImpl, diag.Diagnostics) {
+	id, diags := parseOptionalUuid(m.Id)
+	if diags.HasError() {
+		return sifflet.AsCodeReferenceByIdOrNameDtoImpl{}, diags
+	}
+	return sifflet.AsCodeReferenceByIdOrNameDtoImpl{
+		Id:   id,
+		Name: m.Name.ValueStringPointer(),
+	}, diag.Diagnostics{}
+}
+
+func (m *monitorTermModel) FromDto(_ context.Context, dto sifflet.AsCodeReferenceByIdOrNameDtoImpl) diag.Diagnostics {
+	m.Id = uuidPointerValue(dto.Id)
+	m.Name = types.StringPointerValue(dto.Name)
+	return diag.Diagnostics{}
+}
+
+// monitorNotificationModel is a notification sent when a monitor fails. Depending on the kind of notification,
+// some attributes are null: alerting hooks (such as Slack, email or webhooks) are identified by their ID and name,
+// while Jira and ServiceNow notifications reference a template.
+type monitorNotificationModel struct {
+	Kind         types.String `tfsdk:"kind"`
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	ProjectKey   types.String `tfsdk:"project_key"`
+	IssueTypeId  types.Int64  `tfsdk:"issue_type_id"`
+	TemplateName types.String `tfsdk:"template_name"`
+}
+
+var (
+	_ model.ReadableModel[sifflet.AsCodeMonitorDto_Notifications_Item] = &monitorNotificationModel{}
+)
+
+func (m monitorNotificationModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"kind":          types.StringType,
+		"id":            types.StringType,
+		"name":          types.StringType,
+		"project_key":   types.StringType,
+		"issue_type_id": types.Int64Type,
+		"template_name": types.StringType,
+	}
+}
+
+func (m *monitorNotificationModel) FromDto(_ context.Context, dto sifflet.AsCodeMonitorDto_Notifications_Item) diag.Diagnostics {
+	// The notification variants share the "kind" discriminator, and fields with the same name have the same meaning
+	// in all variants: reading the item as each variant gives all the fields that are set.
+	alertingHookDto, err := dto.AsAsCodeAlertingHookNotificationDto()
+	if err != nil {
+		return tfutils.ErrToDiags("Unable to read monitor notification", err)
+	}
+	jiraDto, err := dto.AsAsCodeJiraNotificationDto()
+	if err != nil {
+		return tfutils.ErrToDiags("Unable to read monitor notification", err)
+	}
+
+	m.Kind = types.StringValue(string(alertingHookDto.Kind))
+	m.Id = uuidPointerValue(alertingHookDto.Id)
+	m.Name = types.StringPointerValue(alertingHookDto.Name)
+	m.ProjectKey = types.StringPointerValue(jiraDto.ProjectKey)
+	m.IssueTypeId = types.Int64PointerValue(jiraDto.IssueTypeId)
+	m.TemplateName = types.StringPointerValue(jiraDto.TemplateName)
+	return diag.Diagnostics{}
+}
+
+// monitorModel is a monitor, as returned by the monitors-as-code API.
+type monitorModel struct {
+	Id               types.String `tfsdk:"id"`
+	FriendlyId       types.String `tfsdk:"friendly_id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Kind             types.String `tfsdk:"kind"`
+	Datasets         types.List   `tfsdk:"datasets"`
+	Schedule         types.String `tfsdk:"schedule"`
+	ScheduleTimezone types.String `tfsdk:"schedule_timezone"`
+	Tags             types.List   `tfsdk:"tags"`
+	Terms            types.List   `tfsdk:"terms"`
+	Notifications    types.List   `tfsdk:"notifications"`
+	Definition       types.String `tfsdk:"definition"`
+}
+
+var (
+	_ model.ReadableModel[sifflet.AsCodeMonitorDto] = &monitorModel{}
+)
+
+func (m monitorModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.StringType,
+		"friendly_id":       types.StringType,
+		"name":              types.StringType,
+		"description":       types.StringType,
+		"kind":              types.StringType,
+		"datasets":          types.ListType{ElemType: types.ObjectType{AttrTypes: monitorDatasetModel{}.AttributeTypes()}},
+		"schedule":          types.StringType,
+		"schedule_timezone": types.StringType,
+		"tags":              types.ListType{ElemType: types.ObjectType{AttrTypes: monitorTagModel{}.AttributeTypes()}},
+		"terms":             types.ListType{ElemType: types.ObjectType{AttrTypes: monitorTermModel{}.AttributeTypes()}},
+		"notifications":     types.ListType{ElemType: types.ObjectType{AttrTypes: monitorNotificationModel{}.AttributeTypes()}},
+		"definition":        types.StringType,
+	}
+}
+
+// monitorKind returns the kind of monitor (e.g "freshness" or "schemaChange"), read from the monitor parameters.
+func monitorKind(parameters sifflet.AsCodeMonitorDto_Parameters) (types.String, diag.Diagnostics) {
+	rawParameters, err := parameters.MarshalJSON()
+	if err != nil {
+		return types.StringNull(), tfutils.ErrToDiags("Unable to read monitor parameters", err)
+	}
+	// All the monitor parameters types have a "kind" field, which acts as a discriminator.
+	var kindOnly struct {
+		Kind *string `json:"kind"`
+	}
+	if err := json.Unmarshal(rawParameters, &kindOnly); err != nil {
+		return types.StringNull(), tfutils.ErrToDiags("Unable to read monitor parameters", err)
+	}
+	return types.StringPointerValue(kindOnly.Kind), diag.Diagnostics{}
+}
+
+func (m *monitorModel) FromDto(ctx context.Context, dto sifflet.AsCodeMonitorDto) diag.Diagnostics {
+	var datasetDtos []sifflet.AsCodeDatasetReferenceDto
+	if dto.Datasets != nil {
+		datasetDtos = *dto.Datasets
+	}
+	datasets, diags := model.NewModelListFromDto(ctx, datasetDtos,
+		func() model.InnerModel[sifflet.AsCodeDatasetReferenceDto] { return &monitorDatasetModel{} },
+	)
+	if diags.HasError() {
+		return diags
+	}
+
+	var tagDtos []sifflet.AsCodeTagReferenceDto
+	if dto.Tags != nil {
+		tagDtos = *dto.Tags
+	}
+	tags, diags := model.NewModelListFromDto(ctx, tagDtos,
+		func() model.InnerModel[sifflet.AsCodeTagReferenceDto] { return &monitorTagModel{} },
+	)
+	if diags.HasError() {
+		return diags
+	}
+
+	var termDtos []sifflet.AsCodeReferenceByIdOrNameDtoImpl
+	if dto.Terms != nil {
+		termDtos = *dto.Terms
+	}
+	terms, diags := model.NewModelListFromDto(ctx, termDtos,
+		func() model.InnerModel[sifflet.AsCodeReferenceByIdOrNameDtoImpl] { return &monitorTermModel{} },
+	)
+	if diags.HasError() {
+		return diags
+	}
+
+	var notificationDtos []sifflet.AsCodeMonitorDto_Notifications_Item
+	if dto.Notifications != nil {
+		notificationDtos = *dto.Notifications
+	}
+	notificationModels, diags := tfutils.MapWithDiagnostics(notificationDtos, func(notificationDto sifflet.AsCodeMonitorDto_Notifications_Item) (monitorNotificationModel, diag.Diagnostics) {
+		var notificationModel monitorNotificationModel
+		diags := notificationModel.FromDto(ctx, notificationDto)
+		return notificationModel, diags
+	})
+	if diags.HasError() {
+		return diags
+	}
+	notifications, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: monitorNotificationModel{}.AttributeTypes()}, notificationModels)
+	if diags.HasError() {
+		return diags
+	}
+
+	kind, diags := monitorKind(dto.Parameters)
+	if diags.HasError() {
+		return diags
+	}
+
+	definition, err := json.Marshal(dto)
+	if err != nil {
+		return tfutils.ErrToDiags("Unable to read monitor definition", err)
+	}
+
+	m.Id = uuidPointerValue(dto.Id)
+	m.FriendlyId = types.StringPointerValue(dto.FriendlyId)
+	m.Name = types.StringValue(dto.Name)
+	m.Description = types.StringPointerValue(dto.Description)
+	m.Kind = kind
+	m.Datasets = datasets
+	m.Schedule = types.StringPointerValue(dto.Schedule)
+	m.ScheduleTimezone = types.StringPointerValue(dto.ScheduleTimezone)
+	m.Tags = tags
+	m.Terms = terms
+	m.Notifications = notifications
+	m.Definition = types.StringValue(string(definition))
+	return diag.Diagnostics{}
+}
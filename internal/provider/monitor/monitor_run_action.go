@@ -0,0 +1,185 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &monitorRunAction{}
+	_ action.ActionWithConfigure = &monitorRunAction{}
+)
+
+const (
+	defaultMonitorRunTimeout      = 10 * time.Minute
+	defaultMonitorRunPollInterval = 5 * time.Second
+)
+
+func newMonitorRunAction() action.Action {
+	return &monitorRunAction{}
+}
+
+type monitorRunAction struct {
+	client *sifflet.ClientWithResponses
+}
+
+type monitorRunActionModel struct {
+	MonitorId           types.String `tfsdk:"monitor_id"`
+	TimeoutSeconds      types.Int64  `tfsdk:"timeout_seconds"`
+	PollIntervalSeconds types.Int64  `tfsdk:"poll_interval_seconds"`
+	FailOnAttention     types.Bool   `tfsdk:"fail_on_attention"`
+}
+
+// Metadata returns the action type name.
+func (a *monitorRunAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_run"
+}
+
+// Schema defines the schema for the action.
+func (a *monitorRunAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a Sifflet monitor and waits for its result.",
+		MarkdownDescription: "Runs a Sifflet monitor and waits for its result. The action fails if the monitor reports an incident, if the run leaves an incident open, " +
+			"if the run requires your attention (see `fail_on_attention`), or if the run fails or times out, " +
+			"which makes it usable as a data quality gate after a deployment (for instance with an `action_trigger` block).\n\n" +
+			"The run status, the run result and the debug SQL query of the monitor (when available) are reported in the diagnostics.\n\n" +
+			"Actions require Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			"monitor_id": schema.StringAttribute{
+				Description: "The ID of the monitor to run.",
+				Required:    true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum time to wait for the monitor run to complete, in seconds. Defaults to %d.", int64(defaultMonitorRunTimeout.Seconds())),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"poll_interval_seconds": schema.Int64Attribute{
+				Description: fmt.Sprintf("Time between two checks of the monitor run status, in seconds. Defaults to %d.", int64(defaultMonitorRunPollInterval.Seconds())),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"fail_on_attention": schema.BoolAttribute{
+				Description: "Whether to fail when the monitor run requires your attention. When false, a warning is reported instead, " +
+					"unless the run left an incident open. Defaults to true.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (a *monitorRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config monitorRunActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(config.MonitorId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Could not parse monitor ID as UUID", err.Error())
+		return
+	}
+
+	timeout := defaultMonitorRunTimeout
+	if !config.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(config.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+	interval := defaultMonitorRunPollInterval
+	if !config.PollIntervalSeconds.IsNull() {
+		interval = time.Duration(config.PollIntervalSeconds.ValueInt64()) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	run, diags := runMonitor(ctx, a.client, id, "Unable to run monitor")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for !monitorRunCompleted(run) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Run of monitor %s: %s", id, monitorRunStatus(run))})
+
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError(
+				"Timed out waiting for the monitor run to complete",
+				fmt.Sprintf("The run of monitor %s didn't complete within %s.\n\n%s", id, timeout, describeMonitorRun(run)),
+			)
+			return
+		case <-time.After(interval):
+		}
+
+		run, diags = getMonitorRun(ctx, a.client, id, run.Id, "Unable to read monitor run")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	switch status := monitorRunStatus(run); {
+	case status == sifflet.RuleRunDtoStatusFAILED:
+		resp.Diagnostics.AddError(
+			"Monitor reported an incident",
+			fmt.Sprintf("The run of monitor %s failed.\n\n%s", id, describeMonitorRun(run)),
+		)
+	case monitorRunHasOpenIncident(run):
+		resp.Diagnostics.AddError(
+			"Monitor reported an incident",
+			fmt.Sprintf("The run of monitor %s left an incident open.\n\n%s", id, describeMonitorRun(run)),
+		)
+	case status == sifflet.RuleRunDtoStatusSUCCESS:
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Run of monitor %s: %s", id, status)})
+	case status == sifflet.RuleRunDtoStatusREQUIRESYOURATTENTION:
+		if config.FailOnAttention.IsNull() || config.FailOnAttention.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Monitor requires your attention",
+				fmt.Sprintf("The run of monitor %s requires your attention in the Sifflet UI. Set fail_on_attention to false to report a warning instead.\n\n%s", id, describeMonitorRun(run)),
+			)
+		} else {
+			resp.Diagnostics.AddWarning(
+				"Monitor requires your attention",
+				fmt.Sprintf("The run of monitor %s requires your attention in the Sifflet UI.\n\n%s", id, describeMonitorRun(run)),
+			)
+		}
+	default:
+		resp.Diagnostics.AddError(
+			"Monitor run failed",
+			fmt.Sprintf("The run of monitor %s didn't complete successfully.\n\n%s", id, describeMonitorRun(run)),
+		)
+	}
+}
+
+func (a *monitorRunAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = clients.Client
+}
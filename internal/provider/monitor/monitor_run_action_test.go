@@ -0,0 +1,209 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func monitorRunActionConfig(monitorId string) string {
+	return providertests.ProviderConfig() + fmt.Sprintf(`
+		action "sifflet_monitor_run" "test" {
+			config {
+				monitor_id = "%s"
+				timeout_seconds = 60
+				poll_interval_seconds = 1
+			}
+		}
+
+		resource "terraform_data" "test" {
+			lifecycle {
+				action_trigger {
+					events  = [after_create]
+					actions = [action.sifflet_monitor_run.test]
+				}
+			}
+		}
+		`, monitorId)
+}
+
+func TestAccMonitorRunActionInvalidMonitorId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      monitorRunActionConfig("not-a-uuid"),
+				ExpectError: regexp.MustCompile("Could not parse monitor ID as UUID"),
+			},
+		},
+	})
+}
+
+func TestAccMonitorRunActionUnknownMonitor(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      monitorRunActionConfig(uuid.NewString()),
+				ExpectError: regexp.MustCompile("Unable to run monitor"),
+			},
+		},
+	})
+}
+
+// findRunnableMonitor returns the ID of a monitor of the test tenant that can be run manually and whose last run has the given status.
+// The API doesn't allow creating monitors, so the test is skipped when the tenant doesn't have such a monitor.
+func findRunnableMonitor(t *testing.T, client *sifflet.ClientWithResponses, status sifflet.GetAllRuleParamsLastRunStatus) uuid.UUID {
+	itemsPerPage := int32(100)
+	response, err := client.GetAllRuleWithResponse(t.Context(), &sifflet.GetAllRuleParams{
+		LastRunStatus: &[]sifflet.GetAllRuleParamsLastRunStatus{status},
+		ItemsPerPage:  &itemsPerPage,
+	})
+	if err != nil {
+		t.Fatalf("Failed to list monitors: %v", err)
+	}
+	if response.JSON200 == nil {
+		t.Fatalf("Failed to list monitors: status %d, body %s", response.StatusCode(), string(response.Body))
+	}
+	for _, monitor := range response.JSON200.SearchRules.Data {
+		if monitor.CanManuallyRun {
+			return monitor.Id
+		}
+	}
+	t.Skipf("No monitor whose last run is %s can be run manually in the test tenant", status)
+	return uuid.Nil
+}
+
+// latestMonitorRun returns the latest run of the monitor, or nil if the monitor was never run.
+func latestMonitorRun(ctx context.Context, client *sifflet.ClientWithResponses, monitorId uuid.UUID) (*sifflet.RuleRunDto, error) {
+	itemsPerPage := int32(1)
+	response, err := client.GetSiffletRuleRunsWithResponse(ctx, monitorId, &sifflet.GetSiffletRuleRunsParams{
+		ItemsPerPage: &itemsPerPage,
+		Sort:         &[]string{"createdDate,DESC"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list monitor runs: %w", err)
+	}
+	if response.JSON200 == nil {
+		return nil, fmt.Errorf("failed to list monitor runs: status %d, body %s", response.StatusCode(), string(response.Body))
+	}
+	if len(response.JSON200.Data) == 0 {
+		return nil, nil
+	}
+	return &response.JSON200.Data[0], nil
+}
+
+// testAccCheckNewMonitorRun checks that the latest run of the monitor is not the previous one, and that it has the expected status.
+func testAccCheckNewMonitorRun(ctx context.Context, client *sifflet.ClientWithResponses, monitorId uuid.UUID, previous *sifflet.RuleRunDto, status sifflet.RuleRunDtoStatus) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		run, err := latestMonitorRun(ctx, client, monitorId)
+		if err != nil {
+			return err
+		}
+		if run == nil || (previous != nil && run.Id == previous.Id) {
+			return fmt.Errorf("monitor %s was not run by the action", monitorId)
+		}
+		if run.Status == nil || *run.Status != status {
+			return fmt.Errorf("expected run %s of monitor %s to be %s, got %v", run.Id, monitorId, status, run.Status)
+		}
+		return nil
+	}
+}
+
+func TestAccMonitorRunActionSuccess(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+	ctx := t.Context()
+	client, err := providertests.ClientForTests(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	monitorId := findRunnableMonitor(t, client, sifflet.GetAllRuleParamsLastRunStatusSUCCESS)
+	previousRun, err := latestMonitorRun(ctx, client, monitorId)
+	if err != nil {
+		t.Fatalf("Failed to get latest monitor run: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// The action waits for the run to complete before returning.
+			{
+				Config: monitorRunActionConfig(monitorId.String()),
+				Check:  testAccCheckNewMonitorRun(ctx, client, monitorId, previousRun, sifflet.RuleRunDtoStatusSUCCESS),
+			},
+		},
+	})
+}
+
+func TestAccMonitorRunActionIncident(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+	ctx := t.Context()
+	client, err := providertests.ClientForTests(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	monitorId := findRunnableMonitor(t, client, sifflet.GetAllRuleParamsLastRunStatusFAILED)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// The action fails and reports the run status, and the debug SQL query when the monitor provides one.
+			{
+				Config:      monitorRunActionConfig(monitorId.String()),
+				ExpectError: regexp.MustCompile(`(?s)Monitor reported an incident.*Run ID: [0-9a-f-]+.*Status: FAILED`),
+			},
+		},
+	})
+}
+
+func TestAccMonitorRunActionRequiresAttention(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+	ctx := t.Context()
+	client, err := providertests.ClientForTests(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	monitorId := findRunnableMonitor(t, client, sifflet.GetAllRuleParamsLastRunStatusREQUIRESYOURATTENTION)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// By default, the action fails when the run requires attention, or when it leaves an incident open.
+			{
+				Config:      monitorRunActionConfig(monitorId.String()),
+				ExpectError: regexp.MustCompile(`(?s)Monitor (requires your attention|reported an incident).*Status: REQUIRES_YOUR_ATTENTION`),
+			},
+		},
+	})
+}
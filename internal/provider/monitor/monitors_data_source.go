@@ -0,0 +1,418 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ datasource.DataSource              = &monitorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &monitorsDataSource{}
+)
+
+func newMonitorsDataSource() datasource.DataSource {
+	return &monitorsDataSource{}
+}
+
+type monitorsDataSource struct {
+	client *sifflet.ClientWithResponses
+}
+
+func (d *monitorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Client
+}
+
+func (d *monitorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitors"
+}
+
+func MonitorsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Return monitors matching search criteria, in their monitors-as-code representation.",
+		Attributes: map[string]schema.Attribute{
+			"max_results": schema.Int32Attribute{
+				Description: "Maximum number of results to return. Default is 1000.",
+				Optional:    true,
+			},
+			"mode": schema.StringAttribute{
+				Description: "Representation of the returned monitors. In RELAXED mode, default values are omitted from the definitions. In STRICT mode, all values are returned. In EXPANDED mode, references to other objects (such as datasets) are returned with all their identifiers (ID, name and URI). Default is the API default.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(sifflet.RELAXED), string(sifflet.STRICT), string(sifflet.EXPANDED)),
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Search criteria.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"text_search": schema.StringAttribute{
+						Description: "Return monitors whose name match this attribute.",
+						Optional:    true,
+					},
+					"monitor_types": schema.ListAttribute{
+						Description: "List of monitor types (rule template names, such as 'freshness' or 'schemaChange') to filter on.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"dataset_ids": schema.ListAttribute{
+						Description: "List of dataset IDs to filter on. Returns monitors that monitor at least one of these datasets.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"source_ids": schema.ListAttribute{
+						Description: "List of source IDs to filter on.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"tag_ids": schema.ListAttribute{
+						Description: "List of tag IDs to filter on.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"domain": schema.StringAttribute{
+						Description: "Name of the domain to search in. Default is the 'All' domain.",
+						Optional:    true,
+					},
+					"criticalities": schema.ListAttribute{
+						Description: "List of monitor criticalities to filter on.",
+						ElementType: types.Int32Type,
+						Optional:    true,
+					},
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "List of monitors returned by the search.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Monitor ID.",
+							Computed:    true,
+						},
+						"friendly_id": schema.StringAttribute{
+							Description: "Monitor friendly ID, used to identify the monitor in monitors-as-code workspaces.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Monitor name.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Monitor description.",
+							Computed:    true,
+						},
+						"kind": schema.StringAttribute{
+							Description: "Monitor type (such as 'freshness' or 'schemaChange').",
+							Computed:    true,
+						},
+						"datasets": schema.ListNestedAttribute{
+							Description: "Datasets monitored by this monitor.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Dataset ID.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "Dataset name.",
+										Computed:    true,
+									},
+									"uri": schema.StringAttribute{
+										Description: "Dataset URI. More about URIs here: https://docs.siffletdata.com/docs/uris.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"schedule": schema.StringAttribute{
+							Description: "When this monitor is scheduled to run (cron expression). Null if the monitor is not scheduled.",
+							Computed:    true,
+						},
+						"schedule_timezone": schema.StringAttribute{
+							Description: "Timezone of the monitor schedule.",
+							Computed:    true,
+						},
+						"tags": schema.ListNestedAttribute{
+							Description: "Tags associated with this monitor.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Tag ID.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "Tag name.",
+										Computed:    true,
+									},
+									"kind": schema.StringAttribute{
+										Description: "Tag kind (such as 'Tag' or 'Classification').",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"terms": schema.ListNestedAttribute{
+							Description: "Business terms associated with this monitor.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Term ID.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "Term name.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"notifications": schema.ListNestedAttribute{
+							Description: "Notifications sent when the monitor fails.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"kind": schema.StringAttribute{
+										Description: "Notification kind (such as 'Slack', 'Email', 'Webhook', 'Jira' or 'ServiceNow').",
+										Computed:    true,
+									},
+									"id": schema.StringAttribute{
+										Description: "ID of the alerting hook (integration) the notification is sent to. Null for Jira and ServiceNow template notifications.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "Name of the alerting hook (integration) the notification is sent to, such as a Slack channel or an email address.",
+										Computed:    true,
+									},
+									"project_key": schema.StringAttribute{
+										Description: "Jira project key. Only set for Jira notifications.",
+										Computed:    true,
+									},
+									"issue_type_id": schema.Int64Attribute{
+										Description: "Jira issue type ID. Only set for Jira notifications.",
+										Computed:    true,
+									},
+									"template_name": schema.StringAttribute{
+										Description: "Name of the Jira or ServiceNow template used to create tickets.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"definition": schema.StringAttribute{
+							Description: "Full monitors-as-code definition of the monitor, encoded as JSON. Use `jsondecode` to access its content, or `yamlencode(jsondecode(...))` to get a YAML definition.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *monitorsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = MonitorsDataSourceSchema(ctx)
+}
+
+type MonitorsDataSourceModel struct {
+	MaxResults types.Int32  `tfsdk:"max_results"`
+	Mode       types.String `tfsdk:"mode"`
+	Filter     types.Object `tfsdk:"filter"`
+	Results    types.List   `tfsdk:"results"`
+}
+
+type FilterModel struct {
+	TextSearch    types.String `tfsdk:"text_search"`
+	MonitorTypes  types.List   `tfsdk:"monitor_types"`
+	DatasetIds    types.List   `tfsdk:"dataset_ids"`
+	SourceIds     types.List   `tfsdk:"source_ids"`
+	TagIds        types.List   `tfsdk:"tag_ids"`
+	Domain        types.String `tfsdk:"domain"`
+	Criticalities types.List   `tfsdk:"criticalities"`
+}
+
+// uuidList parses a list of UUIDs. A null list is returned as nil.
+func uuidList(ctx context.Context, list types.List) (*[]uuid.UUID, diag.Diagnostics) {
+	if list.IsNull() {
+		return nil, diag.Diagnostics{}
+	}
+
+	var ids []string
+	diags := list.ElementsAs(ctx, &ids, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	out, diags := tfutils.MapWithDiagnostics(ids, func(id string) (uuid.UUID, diag.Diagnostics) {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return uuid.Nil, tfutils.ErrToDiags(fmt.Sprintf("Could not parse %s as UUID", id), err)
+		}
+		return parsed, diag.Diagnostics{}
+	})
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &out, diag.Diagnostics{}
+}
+
+// ToParams returns the query parameters corresponding to the filter. Pagination parameters are not set.
+func (m FilterModel) ToParams(ctx context.Context) (sifflet.GetAllMonitorsAsCodeParams, diag.Diagnostics) {
+	var params sifflet.GetAllMonitorsAsCodeParams
+	var diags diag.Diagnostics
+
+	params.TextSearch = m.TextSearch.ValueStringPointer()
+	params.Domain = m.Domain.ValueStringPointer()
+
+	if !m.MonitorTypes.IsNull() {
+		var monitorTypes []string
+		diags = m.MonitorTypes.ElementsAs(ctx, &monitorTypes, false)
+		if diags.HasError() {
+			return sifflet.GetAllMonitorsAsCodeParams{}, diags
+		}
+		params.RuleTemplateName = &monitorTypes
+	}
+
+	if !m.Criticalities.IsNull() {
+		var criticalities []int32
+		diags = m.Criticalities.ElementsAs(ctx, &criticalities, false)
+		if diags.HasError() {
+			return sifflet.GetAllMonitorsAsCodeParams{}, diags
+		}
+		params.Criticality = &criticalities
+	}
+
+	params.Dataset, diags = uuidList(ctx, m.DatasetIds)
+	if diags.HasError() {
+		return sifflet.GetAllMonitorsAsCodeParams{}, diags
+	}
+	params.Datasource, diags = uuidList(ctx, m.SourceIds)
+	if diags.HasError() {
+		return sifflet.GetAllMonitorsAsCodeParams{}, diags
+	}
+	params.Tag, diags = uuidList(ctx, m.TagIds)
+	if diags.HasError() {
+		return sifflet.GetAllMonitorsAsCodeParams{}, diags
+	}
+
+	return params, diag.Diagnostics{}
+}
+
+func (d *monitorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var data MonitorsDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var params sifflet.GetAllMonitorsAsCodeParams
+	if !data.Filter.IsNull() {
+		var filterModel FilterModel
+		diags = data.Filter.As(ctx, &filterModel, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+
+		params, diags = filterModel.ToParams(ctx)
+		if diags.HasError() {
+			resp.Diagnostics.Append(diags...)
+			return
+		}
+	}
+	if !data.Mode.IsNull() {
+		mode := sifflet.GetAllMonitorsAsCodeParamsMode(data.Mode.ValueString())
+		params.Mode = &mode
+	}
+
+	maxResults := data.MaxResults.ValueInt32()
+	if maxResults == 0 {
+		// Set a default
+		maxResults = 1000
+	}
+	results := make([]monitorModel, 0)
+
+	// The page size is fixed, since the API computes the offset of a page from its size. Results
+	// beyond max_results are dropped.
+	var itemsPerPage int32 = 100
+	for page := int32(0); int32(len(results)) < maxResults; page++ { // nolint: gosec
+		params.Page = &page
+		params.ItemsPerPage = &itemsPerPage
+		monitorsResponse, err := d.client.GetAllMonitorsAsCodeWithResponse(ctx, &params)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read monitors", err.Error())
+			return
+		}
+		if monitorsResponse.StatusCode() != http.StatusOK {
+			sifflet.HandleHttpErrorAsProblem(
+				ctx, &resp.Diagnostics, "Unable to read monitors", monitorsResponse.StatusCode(), monitorsResponse.Body,
+			)
+			return
+		}
+
+		monitorDtos := *monitorsResponse.JSON200
+		for _, monitorDto := range monitorDtos {
+			if int32(len(results)) >= maxResults { // nolint: gosec
+				break
+			}
+			var monitorModel monitorModel
+			diags := monitorModel.FromDto(ctx, monitorDto)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				return
+			}
+			results = append(results, monitorModel)
+		}
+
+		if int32(len(monitorDtos)) < itemsPerPage { // nolint: gosec
+			// Last page
+			break
+		}
+	}
+
+	data.Results, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: monitorModel{}.AttributeTypes()}, results)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+}
@@ -0,0 +1,113 @@
+package monitor_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccMonitorsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// No result
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_monitors" "test" {
+					filter = {
+						text_search = "doesn't match any monitor"
+					}
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sifflet_monitors.test", "results.#", "0"),
+				),
+			},
+			// Results are capped by max_results
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_monitors" "test" {
+					max_results = 1
+					mode = "EXPANDED"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.sifflet_monitors.test", "results.#", func(value string) error {
+						count, err := strconv.Atoi(value)
+						if err != nil {
+							return err
+						}
+						if count > 1 {
+							return fmt.Errorf("expected at most 1 result, got %d", count)
+						}
+						return nil
+					}),
+				),
+			},
+			// Results spanning several pages are not duplicated
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_monitors" "test" {
+					max_results = 150
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMonitorsUnique("data.sifflet_monitors.test", 150),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMonitorsDataSourceInvalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_monitors" "test" {
+					mode = "UNKNOWN"
+				}`,
+				ExpectError: regexp.MustCompile(`Attribute mode value must be one of`),
+			},
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_monitors" "test" {
+					filter = {
+						dataset_ids = ["not-a-uuid"]
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`Could not parse not-a-uuid as UUID`),
+			},
+		},
+	})
+}
+
+// testAccCheckMonitorsUnique checks that the data source returned at most maxResults monitors, without duplicates.
+func testAccCheckMonitorsUnique(name string, maxResults int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+		count, err := strconv.Atoi(rs.Primary.Attributes["results.#"])
+		if err != nil {
+			return err
+		}
+		if count > maxResults {
+			return fmt.Errorf("expected at most %d results, got %d", maxResults, count)
+		}
+		ids := make(map[string]bool, count)
+		for i := range count {
+			id := rs.Primary.Attributes[fmt.Sprintf("results.%d.id", i)]
+			if ids[id] {
+				return fmt.Errorf("monitor %s is returned twice", id)
+			}
+			ids[id] = true
+		}
+		return nil
+	}
+}
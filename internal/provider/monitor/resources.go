@@ -0,0 +1,23 @@
+package monitor
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		newMonitorsDataSource,
+	}
+}
+
+func Actions() []func() action.Action {
+	return []func() action.Action{
+		newMonitorRunAction,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -19,18 +20,23 @@ import (
 
 	"terraform-provider-sifflet/internal/apiclients"
 	"terraform-provider-sifflet/internal/provider/asset"
+	"terraform-provider-sifflet/internal/provider/calendar"
 	"terraform-provider-sifflet/internal/provider/credentials"
+	"terraform-provider-sifflet/internal/provider/dbt"
 	"terraform-provider-sifflet/internal/provider/domain"
+	"terraform-provider-sifflet/internal/provider/monitor"
 	"terraform-provider-sifflet/internal/provider/source"
 	"terraform-provider-sifflet/internal/provider/source_v2"
 	"terraform-provider-sifflet/internal/provider/tag"
 	"terraform-provider-sifflet/internal/provider/team"
 	"terraform-provider-sifflet/internal/provider/user"
+	"terraform-provider-sifflet/internal/provider/workspace"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &siffletProvider{}
+	_ provider.Provider            = &siffletProvider{}
+	_ provider.ProviderWithActions = &siffletProvider{}
 )
 
 type siffletProviderModel struct {
@@ -78,7 +84,7 @@ func (p *siffletProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 	}
 }
 
-// Configure prepares a sifflet API client for data sources and resources.
+// Configure prepares a sifflet API client for data sources, resources and actions.
 func (p *siffletProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	// Retrieve provider data from configuration
 	var config siffletProviderModel
@@ -162,10 +168,11 @@ func (p *siffletProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	// Make the Sifflet clients available during DataSource and Resource
-	// type Configure methods.
+	// Make the Sifflet clients available during DataSource, Resource
+	// and Action type Configure methods.
 	resp.DataSourceData = httpClients
 	resp.ResourceData = httpClients
+	resp.ActionData = httpClients
 
 	// Check that the provided URL is valid by making a request
 	// to the Sifflet API.
@@ -201,13 +208,17 @@ func apiHealthCheck(httpClient *http.Client, host string, resp *provider.Configu
 func (p *siffletProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return slices.Concat(
 		asset.DataSources(),
+		calendar.DataSources(),
 		credentials.DataSources(),
+		dbt.DataSources(),
 		domain.DataSources(),
+		monitor.DataSources(),
 		source.DataSources(),
 		source_v2.DataSources(),
 		tag.DataSources(),
 		team.DataSources(),
 		user.DataSources(),
+		workspace.DataSources(),
 	)
 }
 
@@ -215,13 +226,25 @@ func (p *siffletProvider) DataSources(_ context.Context) []func() datasource.Dat
 func (p *siffletProvider) Resources(_ context.Context) []func() resource.Resource {
 	return slices.Concat(
 		asset.Resources(),
+		calendar.Resources(),
 		credentials.Resources(),
+		dbt.Resources(),
 		domain.Resources(),
+		monitor.Resources(),
 		source.Resources(),
 		source_v2.Resources(),
 		tag.Resources(),
 		team.Resources(),
 		user.Resources(),
+		workspace.Resources(),
+	)
+}
+
+// Actions defines the actions implemented in the provider.
+func (p *siffletProvider) Actions(_ context.Context) []func() action.Action {
+	return slices.Concat(
+		source_v2.Actions(),
+		monitor.Actions(),
 	)
 }
 
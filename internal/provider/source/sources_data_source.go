@@ -61,8 +61,8 @@ func SourcesDataSourceSchema(ctx context.Context) schema.Schema {
 	paramsSchema.Computed = true
 	paramsSchema.Required = false
 	return schema.Schema{
-		Description:        "Return sources matching search criteria. **Deprecated.**",
-		DeprecationMessage: "This source relies on a deprecated API that may be removed in the future.",
+		Description:        "Return sources matching search criteria. **Deprecated**: use the sifflet_sources_v2 data source instead.",
+		DeprecationMessage: "This source relies on a deprecated API that may be removed in the future. Use the sifflet_sources_v2 data source instead.",
 		Attributes: map[string]schema.Attribute{
 			"max_results": schema.Int32Attribute{
 				Description: "Maximum number of results to return. Default is 1000.",
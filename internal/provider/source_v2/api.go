@@ -0,0 +1,180 @@
+package source_v2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// getSource reads a source by ID. found is false if the source doesn't exist.
+func getSource(ctx context.Context, client *sifflet.ClientWithResponses, id uuid.UUID, summary string) (dto sifflet.SiffletPublicGetSourceV2Dto, found bool, diags diag.Diagnostics) {
+	sourceResponse, err := client.PublicGetSourceV2WithResponse(ctx, id)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return sifflet.SiffletPublicGetSourceV2Dto{}, false, diags
+	}
+
+	if sourceResponse.StatusCode() == http.StatusNotFound {
+		return sifflet.SiffletPublicGetSourceV2Dto{}, false, diags
+	}
+
+	if sourceResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, sourceResponse.StatusCode(), sourceResponse.Body)
+		return sifflet.SiffletPublicGetSourceV2Dto{}, false, diags
+	}
+
+	err = dto.UnmarshalJSON(sourceResponse.Body)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return sifflet.SiffletPublicGetSourceV2Dto{}, false, diags
+	}
+
+	return dto, true, diags
+}
+
+// getSourceLastRun returns the last ingestion run of a source. The returned value is nil if the source was never run.
+func getSourceLastRun(ctx context.Context, client *sifflet.ClientWithResponses, id uuid.UUID, summary string) (*sifflet.PublicGetLastRunV2Dto, diag.Diagnostics) {
+	dto, found, diags := getSource(ctx, client, id, summary)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if !found {
+		diags.AddError(summary, fmt.Sprintf("Source %s not found", id))
+		return nil, diags
+	}
+
+	source, err := dto.GetSourceDto()
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return nil, diags
+	}
+	return source.GetLastRun(), diags
+}
+
+// triggerSourceIngestion starts an ingestion run of a source.
+func triggerSourceIngestion(ctx context.Context, client *sifflet.ClientWithResponses, id uuid.UUID, summary string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	runResponse, err := client.PublicSourceIngestionManualRunWithResponse(ctx, id)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return diags
+	}
+
+	if runResponse.StatusCode() != http.StatusNoContent {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, runResponse.StatusCode(), runResponse.Body)
+		return diags
+	}
+
+	return diags
+}
+
+// ingestionRunCompleted returns true if lastRun is a completed run (successful or failed) that started after the given time.
+// since is nil if the source was never run before.
+func ingestionRunCompleted(lastRun *sifflet.PublicGetLastRunV2Dto, since *time.Time) bool {
+	if lastRun == nil || lastRun.Status == nil || lastRun.Timestamp == nil {
+		return false
+	}
+	if since != nil && !lastRun.Timestamp.After(*since) {
+		// This is still the previous run
+		return false
+	}
+	return *lastRun.Status == sifflet.PublicGetLastRunV2DtoStatusSUCCESS || *lastRun.Status == sifflet.PublicGetLastRunV2DtoStatusFAILURE
+}
+
+// waitForSourceIngestion polls the source until an ingestion run started after the given time completes, and returns this run.
+// progress is called after each poll with the current status. The context deadline bounds the wait.
+func waitForSourceIngestion(ctx context.Context, client *sifflet.ClientWithResponses, id uuid.UUID, since *time.Time, interval time.Duration, progress func(string), summary string) (*sifflet.PublicGetLastRunV2Dto, diag.Diagnostics) {
+	for {
+		lastRun, diags := getSourceLastRun(ctx, client, id, summary)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if ingestionRunCompleted(lastRun, since) {
+			return lastRun, diags
+		}
+
+		status := "PENDING"
+		if lastRun != nil && lastRun.Status != nil && (since == nil || (lastRun.Timestamp != nil && lastRun.Timestamp.After(*since))) {
+			status = string(*lastRun.Status)
+		}
+		progress(fmt.Sprintf("Ingestion of source %s: %s", id, status))
+
+		select {
+		case <-ctx.Done():
+			diags.AddError(summary, fmt.Sprintf("Timed out waiting for the ingestion of source %s to complete (last status: %s)", id, status))
+			return nil, diags
+		case <-time.After(interval):
+		}
+	}
+}
+
+// testSourceConnection tests the connection to a source with the given parameters and credentials, before the source is created or updated.
+func testSourceConnection(ctx context.Context, client *sifflet.ClientWithResponses, body sifflet.PublicCreateSourceV2JSONBody, summary string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	testDto, err := body.ToTestConnectionDto()
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return diags
+	}
+
+	testResponse, err := client.TestSourceConnectionWithResponse(ctx, testDto)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return diags
+	}
+
+	if testResponse.StatusCode() != http.StatusNoContent {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, testResponse.StatusCode(), testResponse.Body)
+		return diags
+	}
+
+	return diags
+}
+
+// ListSources returns all the sources.
+func ListSources(ctx context.Context, client *sifflet.ClientWithResponses, summary string) ([]sifflet.SiffletPublicGetSourceV2Dto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	sourcesResponse, err := client.PublicGetSourcesV2WithResponse(ctx)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return nil, diags
+	}
+
+	if sourcesResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, sourcesResponse.StatusCode(), sourcesResponse.Body)
+		return nil, diags
+	}
+
+	sources := make([]sifflet.SiffletPublicGetSourceV2Dto, 0, len(sourcesResponse.JSON200.Data))
+	for _, item := range sourcesResponse.JSON200.Data {
+		var source sifflet.SiffletPublicGetSourceV2Dto
+		err := source.FromPublicPageDtoPublicGetSourceV2DtoDataItem(item)
+		if err != nil {
+			diags.AddError(summary, err.Error())
+			return nil, diags
+		}
+		sources = append(sources, source)
+	}
+	return sources, diags
+}
+
+// FindSourceByName returns the source with the given name among the listed sources. It fails if there is no such source, or if several sources have this name.
+func FindSourceByName(sources []sifflet.SiffletPublicGetSourceV2Dto, name string, summary string) (sifflet.SiffletPublicGetSourceV2Dto, diag.Diagnostics) {
+	return tfutils.FindByName(sources, name, func(source sifflet.SiffletPublicGetSourceV2Dto) (string, error) {
+		sourceDto, err := source.GetSourceDto()
+		if err != nil {
+			return "", err
+		}
+		return sourceDto.GetName(), nil
+	}, "source", summary)
+}
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-sifflet/internal/model"
 	parameters "terraform-provider-sifflet/internal/provider/source_v2/parameters_v2"
 	"terraform-provider-sifflet/internal/tfutils"
+	"time"
 
 	"terraform-provider-sifflet/internal/client"
 
@@ -17,14 +18,18 @@ import (
 )
 
 type baseSourceV2Model struct {
-	ID         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	Parameters types.Object `tfsdk:"parameters"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Parameters       types.Object `tfsdk:"parameters"`
+	LastRunStatus    types.String `tfsdk:"last_run_status"`
+	LastRunTimestamp types.String `tfsdk:"last_run_timestamp"`
 }
 
 type sourceV2Model struct {
 	baseSourceV2Model
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	ValidateConnection types.Bool     `tfsdk:"validate_connection"`
+	WaitForIngestion   types.Bool     `tfsdk:"wait_for_ingestion"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 var (
@@ -39,11 +44,15 @@ func (m baseSourceV2Model) AttributeTypes() map[string]attr.Type {
 		"parameters": types.ObjectType{
 			AttrTypes: parameters.ParametersModel{}.AttributeTypes(),
 		},
+		"last_run_status":    types.StringType,
+		"last_run_timestamp": types.StringType,
 	}
 }
 
 func (m sourceV2Model) AttributeTypes() map[string]attr.Type {
 	attrs := m.baseSourceV2Model.AttributeTypes()
+	attrs["validate_connection"] = types.BoolType
+	attrs["wait_for_ingestion"] = types.BoolType
 	attrs["timeouts"] = timeouts.Type{
 		ObjectType: types.ObjectType{
 			AttrTypes: map[string]attr.Type{
@@ -95,10 +104,32 @@ func (m *baseSourceV2Model) FromDto(ctx context.Context, dto client.SiffletPubli
 	}
 	m.ID = types.StringValue(sourceDto.GetId().String())
 	m.Name = types.StringValue(sourceDto.GetName())
+	m.setLastRun(sourceDto.GetLastRun())
 
 	return diag.Diagnostics{}
 }
 
+// setLastRun sets the last run attributes. lastRun is nil if the source was never run.
+func (m *baseSourceV2Model) setLastRun(lastRun *client.PublicGetLastRunV2Dto) {
+	m.LastRunStatus, m.LastRunTimestamp = lastRunValues(lastRun)
+}
+
+// lastRunValues returns the status and the timestamp of the last run of a source. Both are null if the source was never run.
+func lastRunValues(lastRun *client.PublicGetLastRunV2Dto) (status types.String, timestamp types.String) {
+	status = types.StringNull()
+	timestamp = types.StringNull()
+	if lastRun == nil {
+		return status, timestamp
+	}
+	if lastRun.Status != nil {
+		status = types.StringValue(string(*lastRun.Status))
+	}
+	if lastRun.Timestamp != nil {
+		timestamp = types.StringValue(lastRun.Timestamp.Format(time.RFC3339))
+	}
+	return status, timestamp
+}
+
 func (m *sourceV2Model) FromDto(ctx context.Context, dto client.SiffletPublicGetSourceV2Dto) diag.Diagnostics {
 	return m.baseSourceV2Model.FromDto(ctx, dto)
 }
@@ -0,0 +1,147 @@
+package parameters_v2
+
+import (
+	"context"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AdfParametersModel struct {
+	TenantId       types.String `tfsdk:"tenant_id"`
+	SubscriptionId types.String `tfsdk:"subscription_id"`
+	ResourceGroup  types.String `tfsdk:"resource_group"`
+	FactoryName    types.String `tfsdk:"factory_name"`
+	Credentials    types.String `tfsdk:"credentials"`
+	Schedule       types.String `tfsdk:"schedule"`
+}
+
+func (m AdfParametersModel) SchemaSourceType() string {
+	return "adf"
+}
+
+func (m AdfParametersModel) TerraformSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"tenant_id": schema.StringAttribute{
+				Description: "Azure tenant ID",
+				Required:    true,
+			},
+			"subscription_id": schema.StringAttribute{
+				Description: "Azure subscription ID",
+				Required:    true,
+			},
+			"resource_group": schema.StringAttribute{
+				Description: "Azure resource group of the data factory",
+				Required:    true,
+			},
+			"factory_name": schema.StringAttribute{
+				Description: "Name of the data factory",
+				Required:    true,
+			},
+			"credentials": schema.StringAttribute{
+				Description: "Name of the credentials used to connect to the source.",
+				Required:    true,
+			},
+			"schedule": schema.StringAttribute{
+				Description: "Schedule for the source. Must be a valid cron expression. If empty, the source will only be refreshed when manually triggered.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (m AdfParametersModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"tenant_id":       types.StringType,
+		"subscription_id": types.StringType,
+		"resource_group":  types.StringType,
+		"factory_name":    types.StringType,
+		"credentials":     types.StringType,
+		"schedule":        types.StringType,
+	}
+}
+
+func (m AdfParametersModel) AsParametersModel(ctx context.Context) (ParametersModel, diag.Diagnostics) {
+	adfParams, diags := types.ObjectValueFrom(ctx, m.AttributeTypes(), m)
+	if diags.HasError() {
+		return ParametersModel{}, diags
+	}
+	o := NewParametersModel()
+	o.Adf = adfParams
+	return o, diag.Diagnostics{}
+}
+
+func (m AdfParametersModel) ToCreateDto(ctx context.Context, name string) (sifflet.PublicCreateSourceV2JSONBody, diag.Diagnostics) {
+	adfInformation := sifflet.AdfInformation{
+		TenantId:       m.TenantId.ValueString(),
+		SubscriptionId: m.SubscriptionId.ValueString(),
+		ResourceGroup:  m.ResourceGroup.ValueString(),
+		FactoryName:    m.FactoryName.ValueString(),
+	}
+
+	adfCreateDto := sifflet.PublicCreateAdfSourceV2Dto{
+		Name:           name,
+		Type:           sifflet.PublicCreateAdfSourceV2DtoTypeADF,
+		AdfInformation: &adfInformation,
+		Credentials:    m.Credentials.ValueStringPointer(),
+		Schedule:       m.Schedule.ValueStringPointer(),
+	}
+
+	var createSourceJsonBody sifflet.PublicCreateSourceV2JSONBody
+	err := createSourceJsonBody.FromAny(adfCreateDto)
+	if err != nil {
+		return sifflet.PublicCreateSourceV2JSONBody{}, tfutils.ErrToDiags("Cannot create ADF source", err)
+	}
+
+	return createSourceJsonBody, diag.Diagnostics{}
+}
+
+func (m AdfParametersModel) ToUpdateDto(ctx context.Context, name string) (sifflet.PublicEditSourceV2JSONBody, diag.Diagnostics) {
+	adfInformation := sifflet.AdfInformation{
+		TenantId:       m.TenantId.ValueString(),
+		SubscriptionId: m.SubscriptionId.ValueString(),
+		ResourceGroup:  m.ResourceGroup.ValueString(),
+		FactoryName:    m.FactoryName.ValueString(),
+	}
+
+	// The API client doesn't define a dedicated update DTO for ADF sources. The creation DTO
+	// has the same fields, and all of them are sent on update.
+	adfUpdateDto := sifflet.PublicCreateAdfSourceV2Dto{
+		Name:           name,
+		Type:           sifflet.PublicCreateAdfSourceV2DtoTypeADF,
+		AdfInformation: &adfInformation,
+		Credentials:    m.Credentials.ValueStringPointer(),
+		Schedule:       m.Schedule.ValueStringPointer(),
+	}
+
+	var editSourceJsonBody sifflet.PublicEditSourceV2JSONBody
+	err := editSourceJsonBody.FromAny(adfUpdateDto)
+	if err != nil {
+		return sifflet.PublicEditSourceV2JSONBody{}, tfutils.ErrToDiags("Cannot update ADF source", err)
+	}
+
+	return editSourceJsonBody, diag.Diagnostics{}
+}
+
+func (m *AdfParametersModel) ModelFromDto(ctx context.Context, d sifflet.SiffletPublicGetSourceV2Dto) diag.Diagnostics {
+	adfDto := d.PublicGetAdfSourceV2Dto
+	if adfDto == nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Cannot read ADF source", "Source does not contain ADF params but was interpreted as an ADF source")}
+	}
+
+	m.TenantId = types.StringValue(adfDto.AdfInformation.TenantId)
+	m.SubscriptionId = types.StringValue(adfDto.AdfInformation.SubscriptionId)
+	m.ResourceGroup = types.StringValue(adfDto.AdfInformation.ResourceGroup)
+	m.FactoryName = types.StringValue(adfDto.AdfInformation.FactoryName)
+	m.Credentials = types.StringPointerValue(adfDto.Credentials)
+	m.Schedule = types.StringPointerValue(adfDto.Schedule)
+
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,57 @@
+package parameters_v2
+
+import (
+	"fmt"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// DataSourceSchema returns the schema of the parameters in data sources. It has the same structure as the resource schema
+// (see TerraformSchema), with all attributes computed.
+func (m ParametersModel) DataSourceSchema() dsschema.SingleNestedAttribute {
+	resourceSchema := m.TerraformSchema()
+	return dsschema.SingleNestedAttribute{
+		Description: "Connection parameters. Only the nested attribute matching the source type is set.",
+		Computed:    true,
+		Attributes:  toDataSourceAttributes(resourceSchema.Attributes),
+	}
+}
+
+func toDataSourceAttributes(attributes map[string]schema.Attribute) map[string]dsschema.Attribute {
+	out := make(map[string]dsschema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		out[name] = toDataSourceAttribute(attribute)
+	}
+	return out
+}
+
+// toDataSourceAttribute converts a resource schema attribute to a computed data source attribute.
+// Validators and plan modifiers are dropped, as they don't apply to computed attributes.
+func toDataSourceAttribute(attribute schema.Attribute) dsschema.Attribute {
+	switch a := attribute.(type) {
+	case schema.StringAttribute:
+		return dsschema.StringAttribute{Description: a.Description, Sensitive: a.Sensitive, Computed: true}
+	case schema.BoolAttribute:
+		return dsschema.BoolAttribute{Description: a.Description, Sensitive: a.Sensitive, Computed: true}
+	case schema.Int32Attribute:
+		return dsschema.Int32Attribute{Description: a.Description, Sensitive: a.Sensitive, Computed: true}
+	case schema.Int64Attribute:
+		return dsschema.Int64Attribute{Description: a.Description, Sensitive: a.Sensitive, Computed: true}
+	case schema.ListAttribute:
+		return dsschema.ListAttribute{Description: a.Description, Sensitive: a.Sensitive, ElementType: a.ElementType, Computed: true}
+	case schema.SingleNestedAttribute:
+		return dsschema.SingleNestedAttribute{Description: a.Description, Attributes: toDataSourceAttributes(a.Attributes), Computed: true}
+	case schema.ListNestedAttribute:
+		return dsschema.ListNestedAttribute{
+			Description: a.Description,
+			NestedObject: dsschema.NestedAttributeObject{
+				Attributes: toDataSourceAttributes(a.NestedObject.Attributes),
+			},
+			Computed: true,
+		}
+	default:
+		// Only reached if a new attribute type is used in the parameters schemas without being handled here.
+		panic(fmt.Sprintf("unsupported attribute type %T in source parameters schema", attribute))
+	}
+}
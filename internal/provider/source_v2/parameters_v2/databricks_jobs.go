@@ -0,0 +1,134 @@
+package parameters_v2
+
+import (
+	"context"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DatabricksJobsParametersModel struct {
+	Host        types.String `tfsdk:"host"`
+	HttpPath    types.String `tfsdk:"http_path"`
+	Port        types.Int32  `tfsdk:"port"`
+	Credentials types.String `tfsdk:"credentials"`
+	Schedule    types.String `tfsdk:"schedule"`
+}
+
+func (m DatabricksJobsParametersModel) SchemaSourceType() string {
+	return "databricks_jobs"
+}
+
+func (m DatabricksJobsParametersModel) TerraformSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Description: "Databricks workspace host name",
+				Required:    true,
+			},
+			"http_path": schema.StringAttribute{
+				Description: "Databricks HTTP path",
+				Required:    true,
+			},
+			"port": schema.Int32Attribute{
+				Description: "Databricks workspace port",
+				Required:    true,
+			},
+			"credentials": schema.StringAttribute{
+				Description: "Name of the credentials used to connect to the source.",
+				Required:    true,
+			},
+			"schedule": schema.StringAttribute{
+				Description: "Schedule for the source. Must be a valid cron expression. If empty, the source will only be refreshed when manually triggered.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (m DatabricksJobsParametersModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"host":        types.StringType,
+		"http_path":   types.StringType,
+		"port":        types.Int32Type,
+		"credentials": types.StringType,
+		"schedule":    types.StringType,
+	}
+}
+
+func (m DatabricksJobsParametersModel) AsParametersModel(ctx context.Context) (ParametersModel, diag.Diagnostics) {
+	databricksJobsParams, diags := types.ObjectValueFrom(ctx, m.AttributeTypes(), m)
+	if diags.HasError() {
+		return ParametersModel{}, diags
+	}
+	o := NewParametersModel()
+	o.DatabricksJobs = databricksJobsParams
+	return o, diag.Diagnostics{}
+}
+
+func (m DatabricksJobsParametersModel) ToCreateDto(ctx context.Context, name string) (sifflet.PublicCreateSourceV2JSONBody, diag.Diagnostics) {
+	databricksJobsInformation := sifflet.DatabricksJobsInformation{
+		Host:     m.Host.ValueString(),
+		HttpPath: m.HttpPath.ValueString(),
+		Port:     m.Port.ValueInt32(),
+	}
+
+	databricksJobsCreateDto := &sifflet.PublicCreateDatabricksJobsSourceV2Dto{
+		Name:                      name,
+		Type:                      sifflet.PublicCreateDatabricksJobsSourceV2DtoTypeDATABRICKSJOBS,
+		DatabricksJobsInformation: &databricksJobsInformation,
+		Credentials:               m.Credentials.ValueStringPointer(),
+		Schedule:                  m.Schedule.ValueStringPointer(),
+	}
+
+	var createSourceJsonBody sifflet.PublicCreateSourceV2JSONBody
+	err := createSourceJsonBody.FromAny(databricksJobsCreateDto)
+	if err != nil {
+		return sifflet.PublicCreateSourceV2JSONBody{}, tfutils.ErrToDiags("Cannot create Databricks Jobs source", err)
+	}
+
+	return createSourceJsonBody, diag.Diagnostics{}
+}
+
+func (m DatabricksJobsParametersModel) ToUpdateDto(ctx context.Context, name string) (sifflet.PublicEditSourceV2JSONBody, diag.Diagnostics) {
+	databricksJobsInformation := sifflet.DatabricksJobsInformation{
+		Host:     m.Host.ValueString(),
+		HttpPath: m.HttpPath.ValueString(),
+		Port:     m.Port.ValueInt32(),
+	}
+
+	databricksJobsUpdateDto := &sifflet.PublicUpdateDatabricksJobsSourceV2Dto{
+		Name:                      &name,
+		Type:                      sifflet.PublicUpdateDatabricksJobsSourceV2DtoTypeDATABRICKSJOBS,
+		DatabricksJobsInformation: &databricksJobsInformation,
+		Credentials:               m.Credentials.ValueStringPointer(),
+		Schedule:                  m.Schedule.ValueStringPointer(),
+	}
+
+	var editSourceJsonBody sifflet.PublicEditSourceV2JSONBody
+	err := editSourceJsonBody.FromAny(databricksJobsUpdateDto)
+	if err != nil {
+		return sifflet.PublicEditSourceV2JSONBody{}, tfutils.ErrToDiags("Cannot update Databricks Jobs source", err)
+	}
+
+	return editSourceJsonBody, diag.Diagnostics{}
+}
+
+func (m *DatabricksJobsParametersModel) ModelFromDto(ctx context.Context, d sifflet.SiffletPublicGetSourceV2Dto) diag.Diagnostics {
+	databricksJobsDto := d.PublicGetDatabricksJobsSourceV2Dto
+	if databricksJobsDto == nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Cannot read Databricks Jobs source", "Source does not contain Databricks Jobs params but was interpreted as a Databricks Jobs source")}
+	}
+
+	m.Host = types.StringValue(databricksJobsDto.DatabricksJobsInformation.Host)
+	m.HttpPath = types.StringValue(databricksJobsDto.DatabricksJobsInformation.HttpPath)
+	m.Port = types.Int32Value(databricksJobsDto.DatabricksJobsInformation.Port)
+	m.Credentials = types.StringPointerValue(databricksJobsDto.Credentials)
+	m.Schedule = types.StringPointerValue(databricksJobsDto.Schedule)
+	return diag.Diagnostics{}
+}
@@ -0,0 +1,118 @@
+package parameters_v2
+
+import (
+	"context"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type MicrostrategyParametersModel struct {
+	Host        types.String `tfsdk:"host"`
+	Credentials types.String `tfsdk:"credentials"`
+	Schedule    types.String `tfsdk:"schedule"`
+}
+
+func (m MicrostrategyParametersModel) SchemaSourceType() string {
+	return "microstrategy"
+}
+
+func (m MicrostrategyParametersModel) TerraformSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Description: "MicroStrategy server hostname",
+				Required:    true,
+			},
+			"credentials": schema.StringAttribute{
+				Description: "Name of the credentials used to connect to the source.",
+				Required:    true,
+			},
+			"schedule": schema.StringAttribute{
+				Description: "Schedule for the source. Must be a valid cron expression. If empty, the source will only be refreshed when manually triggered.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (m MicrostrategyParametersModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"host":        types.StringType,
+		"credentials": types.StringType,
+		"schedule":    types.StringType,
+	}
+}
+
+func (m MicrostrategyParametersModel) AsParametersModel(ctx context.Context) (ParametersModel, diag.Diagnostics) {
+	microstrategyParams, diags := types.ObjectValueFrom(ctx, m.AttributeTypes(), m)
+	if diags.HasError() {
+		return ParametersModel{}, diags
+	}
+	o := NewParametersModel()
+	o.Microstrategy = microstrategyParams
+	return o, diag.Diagnostics{}
+}
+
+func (m MicrostrategyParametersModel) ToCreateDto(ctx context.Context, name string) (sifflet.PublicCreateSourceV2JSONBody, diag.Diagnostics) {
+	microstrategyInformation := sifflet.MicrostrategyInformation{
+		Host: m.Host.ValueString(),
+	}
+
+	microstrategyCreateDto := sifflet.PublicCreateMicrostrategySourceV2Dto{
+		Name:                     name,
+		Type:                     sifflet.PublicCreateMicrostrategySourceV2DtoTypeMICROSTRATEGY,
+		MicrostrategyInformation: &microstrategyInformation,
+		Credentials:              m.Credentials.ValueStringPointer(),
+		Schedule:                 m.Schedule.ValueStringPointer(),
+	}
+
+	var createSourceJsonBody sifflet.PublicCreateSourceV2JSONBody
+	err := createSourceJsonBody.FromAny(microstrategyCreateDto)
+	if err != nil {
+		return sifflet.PublicCreateSourceV2JSONBody{}, tfutils.ErrToDiags("Cannot create MicroStrategy source", err)
+	}
+
+	return createSourceJsonBody, diag.Diagnostics{}
+}
+
+func (m MicrostrategyParametersModel) ToUpdateDto(ctx context.Context, name string) (sifflet.PublicEditSourceV2JSONBody, diag.Diagnostics) {
+	microstrategyInformation := sifflet.MicrostrategyInformation{
+		Host: m.Host.ValueString(),
+	}
+
+	microstrategyUpdateDto := sifflet.PublicUpdateMicrostrategySourceV2Dto{
+		Name:                     &name,
+		Type:                     sifflet.PublicUpdateMicrostrategySourceV2DtoTypeMICROSTRATEGY,
+		MicrostrategyInformation: &microstrategyInformation,
+		Credentials:              m.Credentials.ValueStringPointer(),
+		Schedule:                 m.Schedule.ValueStringPointer(),
+	}
+
+	var editSourceJsonBody sifflet.PublicEditSourceV2JSONBody
+	err := editSourceJsonBody.FromAny(microstrategyUpdateDto)
+	if err != nil {
+		return sifflet.PublicEditSourceV2JSONBody{}, tfutils.ErrToDiags("Cannot update MicroStrategy source", err)
+	}
+
+	return editSourceJsonBody, diag.Diagnostics{}
+}
+
+func (m *MicrostrategyParametersModel) ModelFromDto(ctx context.Context, d sifflet.SiffletPublicGetSourceV2Dto) diag.Diagnostics {
+	microstrategyDto := d.PublicGetMicrostrategySourceV2Dto
+	if microstrategyDto == nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Cannot read MicroStrategy source", "Source does not contain MicroStrategy params but was interpreted as a MicroStrategy source")}
+	}
+
+	m.Host = types.StringValue(microstrategyDto.MicrostrategyInformation.Host)
+	m.Credentials = types.StringPointerValue(microstrategyDto.Credentials)
+	m.Schedule = types.StringPointerValue(microstrategyDto.Schedule)
+
+	return diag.Diagnostics{}
+}
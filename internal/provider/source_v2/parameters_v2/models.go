@@ -28,24 +28,28 @@ import (
 
 // allSourceTypes is a map of factory functions that return a new instance of a sourceParameters implementation for each supported source type.
 var allSourceTypes = map[string]func() SourceParameters{
-	AirflowParametersModel{}.SchemaSourceType():    func() SourceParameters { return &AirflowParametersModel{} },
-	AthenaParametersModel{}.SchemaSourceType():     func() SourceParameters { return &AthenaParametersModel{} },
-	BigQueryParametersModel{}.SchemaSourceType():   func() SourceParameters { return &BigQueryParametersModel{} },
-	DatabricksParametersModel{}.SchemaSourceType(): func() SourceParameters { return &DatabricksParametersModel{} },
-	DbtParametersModel{}.SchemaSourceType():        func() SourceParameters { return &DbtParametersModel{} },
-	DbtCloudParametersModel{}.SchemaSourceType():   func() SourceParameters { return &DbtCloudParametersModel{} },
-	FivetranParametersModel{}.SchemaSourceType():   func() SourceParameters { return &FivetranParametersModel{} },
-	LookerParametersModel{}.SchemaSourceType():     func() SourceParameters { return &LookerParametersModel{} },
-	MssqlParametersModel{}.SchemaSourceType():      func() SourceParameters { return &MssqlParametersModel{} },
-	MysqlParametersModel{}.SchemaSourceType():      func() SourceParameters { return &MysqlParametersModel{} },
-	OracleParametersModel{}.SchemaSourceType():     func() SourceParameters { return &OracleParametersModel{} },
-	PostgresqlParametersModel{}.SchemaSourceType(): func() SourceParameters { return &PostgresqlParametersModel{} },
-	PowerBiParametersModel{}.SchemaSourceType():    func() SourceParameters { return &PowerBiParametersModel{} },
-	QuickSightParametersModel{}.SchemaSourceType(): func() SourceParameters { return &QuickSightParametersModel{} },
-	RedshiftParametersModel{}.SchemaSourceType():   func() SourceParameters { return &RedshiftParametersModel{} },
-	SnowflakeParametersModel{}.SchemaSourceType():  func() SourceParameters { return &SnowflakeParametersModel{} },
-	SynapseParametersModel{}.SchemaSourceType():    func() SourceParameters { return &SynapseParametersModel{} },
-	TableauParametersModel{}.SchemaSourceType():    func() SourceParameters { return &TableauParametersModel{} },
+	AdfParametersModel{}.SchemaSourceType():            func() SourceParameters { return &AdfParametersModel{} },
+	AirflowParametersModel{}.SchemaSourceType():        func() SourceParameters { return &AirflowParametersModel{} },
+	AthenaParametersModel{}.SchemaSourceType():         func() SourceParameters { return &AthenaParametersModel{} },
+	BigQueryParametersModel{}.SchemaSourceType():       func() SourceParameters { return &BigQueryParametersModel{} },
+	DatabricksParametersModel{}.SchemaSourceType():     func() SourceParameters { return &DatabricksParametersModel{} },
+	DatabricksJobsParametersModel{}.SchemaSourceType(): func() SourceParameters { return &DatabricksJobsParametersModel{} },
+	DbtParametersModel{}.SchemaSourceType():            func() SourceParameters { return &DbtParametersModel{} },
+	DbtCloudParametersModel{}.SchemaSourceType():       func() SourceParameters { return &DbtCloudParametersModel{} },
+	FivetranParametersModel{}.SchemaSourceType():       func() SourceParameters { return &FivetranParametersModel{} },
+	LookerParametersModel{}.SchemaSourceType():         func() SourceParameters { return &LookerParametersModel{} },
+	MicrostrategyParametersModel{}.SchemaSourceType():  func() SourceParameters { return &MicrostrategyParametersModel{} },
+	MssqlParametersModel{}.SchemaSourceType():          func() SourceParameters { return &MssqlParametersModel{} },
+	MysqlParametersModel{}.SchemaSourceType():          func() SourceParameters { return &MysqlParametersModel{} },
+	OracleParametersModel{}.SchemaSourceType():         func() SourceParameters { return &OracleParametersModel{} },
+	PostgresqlParametersModel{}.SchemaSourceType():     func() SourceParameters { return &PostgresqlParametersModel{} },
+	PowerBiParametersModel{}.SchemaSourceType():        func() SourceParameters { return &PowerBiParametersModel{} },
+	QlikParametersModel{}.SchemaSourceType():           func() SourceParameters { return &QlikParametersModel{} },
+	QuickSightParametersModel{}.SchemaSourceType():     func() SourceParameters { return &QuickSightParametersModel{} },
+	RedshiftParametersModel{}.SchemaSourceType():       func() SourceParameters { return &RedshiftParametersModel{} },
+	SnowflakeParametersModel{}.SchemaSourceType():      func() SourceParameters { return &SnowflakeParametersModel{} },
+	SynapseParametersModel{}.SchemaSourceType():        func() SourceParameters { return &SynapseParametersModel{} },
+	TableauParametersModel{}.SchemaSourceType():        func() SourceParameters { return &TableauParametersModel{} },
 }
 
 // ParametersModel represents the parameters for a source, regardless of the source type.
@@ -57,48 +61,56 @@ var allSourceTypes = map[string]func() SourceParameters{
 // Instead, we rely on the SourceParameters interface to handle the conversion between the model and the DTO.
 // This results in code that's probably more complicated than needed - consider refactoring if working on this code.
 type ParametersModel struct {
-	SourceType types.String `tfsdk:"source_type"`
-	Airflow    types.Object `tfsdk:"airflow"`
-	Athena     types.Object `tfsdk:"athena"`
-	BigQuery   types.Object `tfsdk:"bigquery"`
-	Databricks types.Object `tfsdk:"databricks"`
-	Dbt        types.Object `tfsdk:"dbt"`
-	DbtCloud   types.Object `tfsdk:"dbtcloud"`
-	Fivetran   types.Object `tfsdk:"fivetran"`
-	Looker     types.Object `tfsdk:"looker"`
-	Mssql      types.Object `tfsdk:"mssql"`
-	Mysql      types.Object `tfsdk:"mysql"`
-	Oracle     types.Object `tfsdk:"oracle"`
-	Postgresql types.Object `tfsdk:"postgresql"`
-	PowerBi    types.Object `tfsdk:"power_bi"`
-	QuickSight types.Object `tfsdk:"quicksight"`
-	Redshift   types.Object `tfsdk:"redshift"`
-	Snowflake  types.Object `tfsdk:"snowflake"`
-	Synapse    types.Object `tfsdk:"synapse"`
-	Tableau    types.Object `tfsdk:"tableau"`
+	SourceType     types.String `tfsdk:"source_type"`
+	Adf            types.Object `tfsdk:"adf"`
+	Airflow        types.Object `tfsdk:"airflow"`
+	Athena         types.Object `tfsdk:"athena"`
+	BigQuery       types.Object `tfsdk:"bigquery"`
+	Databricks     types.Object `tfsdk:"databricks"`
+	DatabricksJobs types.Object `tfsdk:"databricks_jobs"`
+	Dbt            types.Object `tfsdk:"dbt"`
+	DbtCloud       types.Object `tfsdk:"dbtcloud"`
+	Fivetran       types.Object `tfsdk:"fivetran"`
+	Looker         types.Object `tfsdk:"looker"`
+	Microstrategy  types.Object `tfsdk:"microstrategy"`
+	Mssql          types.Object `tfsdk:"mssql"`
+	Mysql          types.Object `tfsdk:"mysql"`
+	Oracle         types.Object `tfsdk:"oracle"`
+	Postgresql     types.Object `tfsdk:"postgresql"`
+	PowerBi        types.Object `tfsdk:"power_bi"`
+	Qlik           types.Object `tfsdk:"qlik"`
+	QuickSight     types.Object `tfsdk:"quicksight"`
+	Redshift       types.Object `tfsdk:"redshift"`
+	Snowflake      types.Object `tfsdk:"snowflake"`
+	Synapse        types.Object `tfsdk:"synapse"`
+	Tableau        types.Object `tfsdk:"tableau"`
 }
 
 func NewParametersModel() ParametersModel {
 	return ParametersModel{
-		SourceType: types.StringNull(),
-		Airflow:    types.ObjectNull(AirflowParametersModel{}.AttributeTypes()),
-		Athena:     types.ObjectNull(AthenaParametersModel{}.AttributeTypes()),
-		BigQuery:   types.ObjectNull(BigQueryParametersModel{}.AttributeTypes()),
-		Databricks: types.ObjectNull(DatabricksParametersModel{}.AttributeTypes()),
-		Dbt:        types.ObjectNull(DbtParametersModel{}.AttributeTypes()),
-		DbtCloud:   types.ObjectNull(DbtCloudParametersModel{}.AttributeTypes()),
-		Fivetran:   types.ObjectNull(FivetranParametersModel{}.AttributeTypes()),
-		Looker:     types.ObjectNull(LookerParametersModel{}.AttributeTypes()),
-		Mssql:      types.ObjectNull(MssqlParametersModel{}.AttributeTypes()),
-		Mysql:      types.ObjectNull(MysqlParametersModel{}.AttributeTypes()),
-		Oracle:     types.ObjectNull(OracleParametersModel{}.AttributeTypes()),
-		Postgresql: types.ObjectNull(PostgresqlParametersModel{}.AttributeTypes()),
-		PowerBi:    types.ObjectNull(PowerBiParametersModel{}.AttributeTypes()),
-		QuickSight: types.ObjectNull(QuickSightParametersModel{}.AttributeTypes()),
-		Redshift:   types.ObjectNull(RedshiftParametersModel{}.AttributeTypes()),
-		Snowflake:  types.ObjectNull(SnowflakeParametersModel{}.AttributeTypes()),
-		Synapse:    types.ObjectNull(SynapseParametersModel{}.AttributeTypes()),
-		Tableau:    types.ObjectNull(TableauParametersModel{}.AttributeTypes()),
+		SourceType:     types.StringNull(),
+		Adf:            types.ObjectNull(AdfParametersModel{}.AttributeTypes()),
+		Airflow:        types.ObjectNull(AirflowParametersModel{}.AttributeTypes()),
+		Athena:         types.ObjectNull(AthenaParametersModel{}.AttributeTypes()),
+		BigQuery:       types.ObjectNull(BigQueryParametersModel{}.AttributeTypes()),
+		Databricks:     types.ObjectNull(DatabricksParametersModel{}.AttributeTypes()),
+		DatabricksJobs: types.ObjectNull(DatabricksJobsParametersModel{}.AttributeTypes()),
+		Dbt:            types.ObjectNull(DbtParametersModel{}.AttributeTypes()),
+		DbtCloud:       types.ObjectNull(DbtCloudParametersModel{}.AttributeTypes()),
+		Fivetran:       types.ObjectNull(FivetranParametersModel{}.AttributeTypes()),
+		Looker:         types.ObjectNull(LookerParametersModel{}.AttributeTypes()),
+		Microstrategy:  types.ObjectNull(MicrostrategyParametersModel{}.AttributeTypes()),
+		Mssql:          types.ObjectNull(MssqlParametersModel{}.AttributeTypes()),
+		Mysql:          types.ObjectNull(MysqlParametersModel{}.AttributeTypes()),
+		Oracle:         types.ObjectNull(OracleParametersModel{}.AttributeTypes()),
+		Postgresql:     types.ObjectNull(PostgresqlParametersModel{}.AttributeTypes()),
+		PowerBi:        types.ObjectNull(PowerBiParametersModel{}.AttributeTypes()),
+		Qlik:           types.ObjectNull(QlikParametersModel{}.AttributeTypes()),
+		QuickSight:     types.ObjectNull(QuickSightParametersModel{}.AttributeTypes()),
+		Redshift:       types.ObjectNull(RedshiftParametersModel{}.AttributeTypes()),
+		Snowflake:      types.ObjectNull(SnowflakeParametersModel{}.AttributeTypes()),
+		Synapse:        types.ObjectNull(SynapseParametersModel{}.AttributeTypes()),
+		Tableau:        types.ObjectNull(TableauParametersModel{}.AttributeTypes()),
 	}
 }
 
@@ -268,19 +280,23 @@ func (m ParametersModel) GetSourceParameters(ctx context.Context) (SourceParamet
 		value types.Object
 		model func() SourceParameters
 	}{
+		{m.Adf, func() SourceParameters { return &AdfParametersModel{} }},
 		{m.Airflow, func() SourceParameters { return &AirflowParametersModel{} }},
 		{m.Athena, func() SourceParameters { return &AthenaParametersModel{} }},
 		{m.BigQuery, func() SourceParameters { return &BigQueryParametersModel{} }},
 		{m.Databricks, func() SourceParameters { return &DatabricksParametersModel{} }},
+		{m.DatabricksJobs, func() SourceParameters { return &DatabricksJobsParametersModel{} }},
 		{m.DbtCloud, func() SourceParameters { return &DbtCloudParametersModel{} }},
 		{m.Dbt, func() SourceParameters { return &DbtParametersModel{} }},
 		{m.Fivetran, func() SourceParameters { return &FivetranParametersModel{} }},
 		{m.Looker, func() SourceParameters { return &LookerParametersModel{} }},
+		{m.Microstrategy, func() SourceParameters { return &MicrostrategyParametersModel{} }},
 		{m.Mssql, func() SourceParameters { return &MssqlParametersModel{} }},
 		{m.Mysql, func() SourceParameters { return &MysqlParametersModel{} }},
 		{m.Oracle, func() SourceParameters { return &OracleParametersModel{} }},
 		{m.Postgresql, func() SourceParameters { return &PostgresqlParametersModel{} }},
 		{m.PowerBi, func() SourceParameters { return &PowerBiParametersModel{} }},
+		{m.Qlik, func() SourceParameters { return &QlikParametersModel{} }},
 		{m.QuickSight, func() SourceParameters { return &QuickSightParametersModel{} }},
 		{m.Redshift, func() SourceParameters { return &RedshiftParametersModel{} }},
 		{m.Snowflake, func() SourceParameters { return &SnowflakeParametersModel{} }},
@@ -0,0 +1,118 @@
+package parameters_v2
+
+import (
+	"context"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type QlikParametersModel struct {
+	Host        types.String `tfsdk:"host"`
+	Credentials types.String `tfsdk:"credentials"`
+	Schedule    types.String `tfsdk:"schedule"`
+}
+
+func (m QlikParametersModel) SchemaSourceType() string {
+	return "qlik"
+}
+
+func (m QlikParametersModel) TerraformSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Description: "Qlik server URL",
+				Required:    true,
+			},
+			"credentials": schema.StringAttribute{
+				Description: "Name of the credentials used to connect to the source.",
+				Required:    true,
+			},
+			"schedule": schema.StringAttribute{
+				Description: "Schedule for the source. Must be a valid cron expression. If empty, the source will only be refreshed when manually triggered.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (m QlikParametersModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"host":        types.StringType,
+		"credentials": types.StringType,
+		"schedule":    types.StringType,
+	}
+}
+
+func (m QlikParametersModel) AsParametersModel(ctx context.Context) (ParametersModel, diag.Diagnostics) {
+	qlikParams, diags := types.ObjectValueFrom(ctx, m.AttributeTypes(), m)
+	if diags.HasError() {
+		return ParametersModel{}, diags
+	}
+	o := NewParametersModel()
+	o.Qlik = qlikParams
+	return o, diag.Diagnostics{}
+}
+
+func (m QlikParametersModel) ToCreateDto(ctx context.Context, name string) (sifflet.PublicCreateSourceV2JSONBody, diag.Diagnostics) {
+	qlikInformation := sifflet.QlikInformation{
+		Host: m.Host.ValueString(),
+	}
+
+	qlikCreateDto := sifflet.PublicCreateQlikSourceV2Dto{
+		Name:            name,
+		Type:            sifflet.PublicCreateQlikSourceV2DtoTypeQLIK,
+		QlikInformation: &qlikInformation,
+		Credentials:     m.Credentials.ValueStringPointer(),
+		Schedule:        m.Schedule.ValueStringPointer(),
+	}
+
+	var createSourceJsonBody sifflet.PublicCreateSourceV2JSONBody
+	err := createSourceJsonBody.FromAny(qlikCreateDto)
+	if err != nil {
+		return sifflet.PublicCreateSourceV2JSONBody{}, tfutils.ErrToDiags("Cannot create Qlik source", err)
+	}
+
+	return createSourceJsonBody, diag.Diagnostics{}
+}
+
+func (m QlikParametersModel) ToUpdateDto(ctx context.Context, name string) (sifflet.PublicEditSourceV2JSONBody, diag.Diagnostics) {
+	qlikInformation := sifflet.QlikInformation{
+		Host: m.Host.ValueString(),
+	}
+
+	qlikUpdateDto := sifflet.PublicUpdateQlikSourceV2Dto{
+		Name:            &name,
+		Type:            sifflet.PublicUpdateQlikSourceV2DtoTypeQLIK,
+		QlikInformation: &qlikInformation,
+		Credentials:     m.Credentials.ValueStringPointer(),
+		Schedule:        m.Schedule.ValueStringPointer(),
+	}
+
+	var editSourceJsonBody sifflet.PublicEditSourceV2JSONBody
+	err := editSourceJsonBody.FromAny(qlikUpdateDto)
+	if err != nil {
+		return sifflet.PublicEditSourceV2JSONBody{}, tfutils.ErrToDiags("Cannot update Qlik source", err)
+	}
+
+	return editSourceJsonBody, diag.Diagnostics{}
+}
+
+func (m *QlikParametersModel) ModelFromDto(ctx context.Context, d sifflet.SiffletPublicGetSourceV2Dto) diag.Diagnostics {
+	qlikDto := d.PublicGetQlikSourceV2Dto
+	if qlikDto == nil {
+		return diag.Diagnostics{diag.NewErrorDiagnostic("Cannot read Qlik source", "Source does not contain Qlik params but was interpreted as a Qlik source")}
+	}
+
+	m.Host = types.StringValue(qlikDto.QlikInformation.Host)
+	m.Credentials = types.StringPointerValue(qlikDto.Credentials)
+	m.Schedule = types.StringPointerValue(qlikDto.Schedule)
+
+	return diag.Diagnostics{}
+}
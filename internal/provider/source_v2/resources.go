@@ -1,6 +1,7 @@
 package source_v2
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
@@ -14,5 +15,13 @@ func Resources() []func() resource.Resource {
 func DataSources() []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		newSourceV2SchemasDataSource,
+		newSourceV2DataSource,
+		newSourcesV2DataSource,
+	}
+}
+
+func Actions() []func() action.Action {
+	return []func() action.Action{
+		newSourceIngestionRunAction,
 	}
 }
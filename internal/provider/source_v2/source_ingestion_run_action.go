@@ -0,0 +1,153 @@
+package source_v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &sourceIngestionRunAction{}
+	_ action.ActionWithConfigure = &sourceIngestionRunAction{}
+)
+
+const (
+	defaultIngestionRunTimeout      = 30 * time.Minute
+	defaultIngestionRunPollInterval = 10 * time.Second
+)
+
+func newSourceIngestionRunAction() action.Action {
+	return &sourceIngestionRunAction{}
+}
+
+type sourceIngestionRunAction struct {
+	client *sifflet.ClientWithResponses
+}
+
+type sourceIngestionRunActionModel struct {
+	SourceId          types.String `tfsdk:"source_id"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	TimeoutSeconds    types.Int64  `tfsdk:"timeout_seconds"`
+}
+
+// Metadata returns the action type name.
+func (a *sourceIngestionRunAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_ingestion_run"
+}
+
+// Schema defines the schema for the action.
+func (a *sourceIngestionRunAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers an ingestion run of a Sifflet source.",
+		MarkdownDescription: "Triggers an ingestion run of a Sifflet source, for instance after creating or updating a `sifflet_source_v2` resource (with an `action_trigger` block). " +
+			"Optionally waits until the run completes, and fails if the run fails.\n\n" +
+			"Actions require Terraform 1.14 or later.",
+		Attributes: map[string]schema.Attribute{
+			"source_id": schema.StringAttribute{
+				Description: "The ID of the source to run.",
+				Required:    true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description: "Whether to wait until the ingestion run completes. When true, the action fails if the run fails. Defaults to false.",
+				Optional:    true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum time to wait for the ingestion run to complete, in seconds. Only used when wait_for_completion is true. Defaults to %d.", int64(defaultIngestionRunTimeout.Seconds())),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *sourceIngestionRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config sourceIngestionRunActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(config.SourceId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Could not parse source ID as UUID", err.Error())
+		return
+	}
+
+	timeout := defaultIngestionRunTimeout
+	if !config.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(config.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	wait := config.WaitForCompletion.ValueBool()
+
+	// Remember the previous run, to tell it apart from the run triggered below
+	var since *time.Time
+	if wait {
+		lastRun, diags := getSourceLastRun(ctx, a.client, id, "Unable to read source")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if lastRun != nil {
+			since = lastRun.Timestamp
+		}
+	}
+
+	resp.Diagnostics.Append(triggerSourceIngestion(ctx, a.client, id, "Unable to trigger source ingestion run")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !wait {
+		return
+	}
+
+	progress := func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
+	lastRun, diags := waitForSourceIngestion(ctx, a.client, id, since, defaultIngestionRunPollInterval, progress, "Unable to wait for source ingestion run")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if *lastRun.Status == sifflet.PublicGetLastRunV2DtoStatusFAILURE {
+		resp.Diagnostics.AddError(
+			"Source ingestion run failed",
+			fmt.Sprintf("The ingestion run of source %s started at %s failed. Check the source in the Sifflet UI for details.", id, lastRun.Timestamp.Format(time.RFC3339)),
+		)
+		return
+	}
+}
+
+func (a *sourceIngestionRunAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = clients.Client
+}
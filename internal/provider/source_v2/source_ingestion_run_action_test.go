@@ -0,0 +1,85 @@
+package source_v2_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSourceIngestionRunAction(t *testing.T) {
+	sourceName := randomSourceName()
+	project := providertests.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					action "sifflet_source_ingestion_run" "test" {
+						config {
+							source_id = sifflet_source_v2.test.id
+						}
+					}
+
+					resource "sifflet_source_v2" "test" {
+						name = "%s"
+						parameters = {
+							dbt = {
+								target = "target"
+								project_name = "%s"
+							}
+						}
+
+						lifecycle {
+							action_trigger {
+								events  = [after_create]
+								actions = [action.sifflet_source_ingestion_run.test]
+							}
+						}
+					}
+					`, sourceName, project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "name", sourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceIngestionRunActionInvalidSourceId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + `
+					action "sifflet_source_ingestion_run" "test" {
+						config {
+							source_id = "not-a-uuid"
+						}
+					}
+
+					resource "terraform_data" "test" {
+						lifecycle {
+							action_trigger {
+								events  = [after_create]
+								actions = [action.sifflet_source_ingestion_run.test]
+							}
+						}
+					}
+					`,
+				ExpectError: regexp.MustCompile("Could not parse source ID as UUID"),
+			},
+		},
+	})
+}
@@ -0,0 +1,162 @@
+package source_v2
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	parameters "terraform-provider-sifflet/internal/provider/source_v2/parameters_v2"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ datasource.DataSource                     = &sourceV2DataSource{}
+	_ datasource.DataSourceWithConfigure        = &sourceV2DataSource{}
+	_ datasource.DataSourceWithConfigValidators = &sourceV2DataSource{}
+)
+
+func newSourceV2DataSource() datasource.DataSource {
+	return &sourceV2DataSource{}
+}
+
+type sourceV2DataSource struct {
+	client *sifflet.ClientWithResponses
+}
+
+func (d *sourceV2DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Client
+}
+
+func (d *sourceV2DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_source_v2"
+}
+
+// sourceV2Attributes returns the computed attributes of a source, shared by the source_v2 and sources_v2 data sources.
+func sourceV2Attributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the source.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Source name.",
+			Computed:    true,
+		},
+		"parameters": parameters.ParametersModel{}.DataSourceSchema(),
+		"last_run_status": schema.StringAttribute{
+			Description: "Status of the last ingestion run of the source (RUNNING, SUCCESS or FAILURE). Null if the source was never run.",
+			Computed:    true,
+		},
+		"last_run_timestamp": schema.StringAttribute{
+			Description: "Start time of the last ingestion run of the source, in RFC 3339 format. Null if the source was never run.",
+			Computed:    true,
+		},
+	}
+}
+
+func SourceV2DataSourceSchema(ctx context.Context) schema.Schema {
+	attributes := sourceV2Attributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The ID of the source. Exactly one of id or name must be set.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "The name of the source. Exactly one of id or name must be set. The data source fails if several sources have this name.",
+		Optional:    true,
+		Computed:    true,
+	}
+	return schema.Schema{
+		Description: "Read a Sifflet source by its ID or by its name. The parameters have the same structure as in the sifflet_source_v2 resource.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *sourceV2DataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = SourceV2DataSourceSchema(ctx)
+}
+
+func (d *sourceV2DataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *sourceV2DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var data baseSourceV2Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var sourceDto sifflet.SiffletPublicGetSourceV2Dto
+	if !data.ID.IsNull() {
+		id, err := uuid.Parse(data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Could not parse source ID as UUID", err.Error())
+			return
+		}
+
+		dto, found, diags := getSource(ctx, d.client, id, "Unable to read source")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !found {
+			resp.Diagnostics.AddError("Unable to read source", fmt.Sprintf("Source %s not found", id))
+			return
+		}
+		sourceDto = dto
+	} else {
+		sources, diags := ListSources(ctx, d.client, "Unable to read source")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		dto, diags := FindSourceByName(sources, data.Name.ValueString(), "Unable to read source")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		sourceDto = dto
+	}
+
+	diags := data.FromDto(ctx, sourceDto)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
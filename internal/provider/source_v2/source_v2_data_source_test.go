@@ -0,0 +1,83 @@
+package source_v2_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSourceV2DataSource(t *testing.T) {
+	sourceName := randomSourceName()
+	project := providertests.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_source_v2" "test" {
+						name = "%s"
+						parameters = {
+							dbt = {
+								target = "target"
+								project_name = "%s"
+							}
+						}
+					}
+
+					data "sifflet_source_v2" "by_id" {
+						id = sifflet_source_v2.test.id
+					}
+
+					data "sifflet_source_v2" "by_name" {
+						name = sifflet_source_v2.test.name
+					}
+				`, sourceName, project),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sifflet_source_v2.by_id", "id", "sifflet_source_v2.test", "id"),
+					resource.TestCheckResourceAttr("data.sifflet_source_v2.by_id", "name", sourceName),
+					resource.TestCheckResourceAttr("data.sifflet_source_v2.by_id", "parameters.source_type", "dbt"),
+					resource.TestCheckResourceAttr("data.sifflet_source_v2.by_id", "parameters.dbt.project_name", project),
+					resource.TestCheckResourceAttr("data.sifflet_source_v2.by_id", "parameters.dbt.target", "target"),
+					resource.TestCheckNoResourceAttr("data.sifflet_source_v2.by_id", "parameters.mysql"),
+					resource.TestCheckResourceAttrPair("data.sifflet_source_v2.by_name", "id", "sifflet_source_v2.test", "id"),
+					resource.TestCheckResourceAttr("data.sifflet_source_v2.by_name", "parameters.dbt.project_name", project),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceV2DataSourceError(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_source_v2" "test" {
+					id = "00000000-0000-0000-0000-000000000000"
+				}`,
+				ExpectError: regexp.MustCompile("Source 00000000-0000-0000-0000-000000000000 not found"),
+			},
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+				data "sifflet_source_v2" "test" {
+					name = "%s"
+				}`, randomSourceName()),
+				ExpectError: regexp.MustCompile("No source named"),
+			},
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_source_v2" "test" {
+					id = "00000000-0000-0000-0000-000000000000"
+					name = "name"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
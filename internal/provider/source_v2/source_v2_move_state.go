@@ -0,0 +1,267 @@
+package source_v2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/provider/source"
+	parameters "terraform-provider-sifflet/internal/provider/source_v2/parameters_v2"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ resource.ResourceWithMoveState = &sourceV2Resource{}
+
+// Source types whose name differ between the parameters of sifflet_source and the parameters of sifflet_source_v2.
+// Other source types have the same name in both resources.
+var sourceV1ToV2Types = map[string]string{
+	"dbt_cloud": "dbtcloud",
+}
+
+// Private state key set on the states moved from sifflet_source, until the ID of the source is resolved during the next refresh.
+const privateKeyMovedFromSourceV1 = "moved_from_sifflet_source"
+
+// MoveState allows moving sifflet_source resources to sifflet_source_v2 with a `moved` block, without re-importing them.
+func (r *sourceV2Resource) MoveState(ctx context.Context) []resource.StateMover {
+	sourceSchema := source.SourceResourceSchema(ctx)
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema,
+			StateMover:   moveStateFromSourceV1,
+		},
+	}
+}
+
+func moveStateFromSourceV1(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != "sifflet_source" {
+		return
+	}
+	// Ignore the hostname, and compare the namespace case-insensitively (the provider is published as Siffletdata/sifflet).
+	if !strings.HasSuffix(strings.ToLower(req.SourceProviderAddress), "/siffletdata/sifflet") {
+		return
+	}
+	if req.SourceState == nil {
+		resp.Diagnostics.AddError(
+			"Unable to move sifflet_source state",
+			"The sifflet_source state could not be read. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var id, name, credentials, schedule types.String
+	var sourceParameters types.Object
+	var sourceTimeouts timeouts.Value
+	resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root("credentials"), &credentials)...)
+	resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root("schedule"), &schedule)...)
+	resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root("parameters"), &sourceParameters)...)
+	resp.Diagnostics.Append(req.SourceState.GetAttribute(ctx, path.Root("timeouts"), &sourceTimeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targetParameters, diags := parametersFromSourceV1(ctx, sourceParameters, credentials, schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	targetState := sourceV2Model{
+		baseSourceV2Model: baseSourceV2Model{
+			ID:               id,
+			Name:             name,
+			Parameters:       targetParameters,
+			LastRunStatus:    types.StringNull(),
+			LastRunTimestamp: types.StringNull(),
+		},
+		ValidateConnection: types.BoolNull(),
+		WaitForIngestion:   types.BoolNull(),
+		Timeouts:           sourceTimeouts,
+	}
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, targetState)...)
+	// The ID of a sifflet_source resource is the ID of a sub-source, which usually differs from the ID of the source
+	// returned by the v2 API. Resolving it requires API calls, which are not possible here: it's done during the next refresh.
+	resp.Diagnostics.Append(resp.TargetPrivate.SetKey(ctx, privateKeyMovedFromSourceV1, []byte(`true`))...)
+}
+
+// resolveMovedSourceId returns the ID of the source matching a state moved from sifflet_source.
+// If the ID from the sifflet_source state isn't the ID of a source, the source is looked up by type and connection parameters.
+func resolveMovedSourceId(ctx context.Context, client *sifflet.ClientWithResponses, state sourceV2Model) (uuid.UUID, diag.Diagnostics) {
+	summary := "Unable to find the source moved from sifflet_source"
+
+	id, diags := state.ModelId()
+	if diags.HasError() {
+		return uuid.Nil, diags
+	}
+	_, found, diags := getSource(ctx, client, id, summary)
+	if diags.HasError() {
+		return uuid.Nil, diags
+	}
+	if found {
+		return id, diags
+	}
+
+	sourceDtos, diags := ListSources(ctx, client, summary)
+	if diags.HasError() {
+		return uuid.Nil, diags
+	}
+	var matches []string
+	for _, sourceDto := range sourceDtos {
+		var candidate baseSourceV2Model
+		diags = candidate.FromDto(ctx, sourceDto)
+		if diags.HasError() {
+			return uuid.Nil, diags
+		}
+		if sameConnectionParameters(state.Parameters, candidate.Parameters) {
+			matches = append(matches, candidate.ID.ValueString())
+		}
+	}
+
+	importHint := fmt.Sprintf("Remove the resource from the state and import the source instead (the sifflet_source ID was %s).", id)
+	switch len(matches) {
+	case 0:
+		diags.AddError(summary, "No source with the same type and connection parameters was found. "+importHint)
+		return uuid.Nil, diags
+	case 1:
+		id, err := uuid.Parse(matches[0])
+		if err != nil {
+			diags.AddError(summary, err.Error())
+		}
+		return id, diags
+	default:
+		diags.AddError(summary, fmt.Sprintf("Several sources have the same type and connection parameters (%s). %s", strings.Join(matches, ", "), importHint))
+		return uuid.Nil, diags
+	}
+}
+
+// sameConnectionParameters returns whether the parameters of a source moved from sifflet_source match the parameters of an existing source.
+// The schedule is ignored, since it may differ between a sub-source and its source, as well as the parameters missing from the moved state.
+func sameConnectionParameters(moved types.Object, candidate types.Object) bool {
+	movedType, ok := moved.Attributes()["source_type"].(types.String)
+	if !ok {
+		return false
+	}
+	candidateType, ok := candidate.Attributes()["source_type"].(types.String)
+	if !ok || !movedType.Equal(candidateType) {
+		return false
+	}
+
+	movedParameters, ok := moved.Attributes()[movedType.ValueString()].(types.Object)
+	if !ok {
+		return false
+	}
+	candidateParameters, ok := candidate.Attributes()[movedType.ValueString()].(types.Object)
+	if !ok {
+		return false
+	}
+	candidateValues := candidateParameters.Attributes()
+	for attrName, value := range movedParameters.Attributes() {
+		if attrName == "schedule" || value.IsNull() {
+			continue
+		}
+		if !value.Equal(candidateValues[attrName]) {
+			return false
+		}
+	}
+	return true
+}
+
+// parametersFromSourceV1 converts the parameters of a sifflet_source resource to the parameters of a sifflet_source_v2 resource.
+// The credentials and the schedule, which are top-level attributes of sifflet_source, are moved to the parameters.
+// Parameters that don't exist anymore in sifflet_source_v2 (such as the database and schema of some source types) are dropped,
+// with a warning: several sifflet_source resources that only differ by these parameters match the same sifflet_source_v2 source.
+func parametersFromSourceV1(ctx context.Context, sourceParameters types.Object, credentials types.String, schedule types.String) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	nullParameters := types.ObjectNull(parameters.ParametersModel{}.AttributeTypes())
+
+	var sourceType string
+	var sourceTypeParameters types.Object
+	for attrName, value := range sourceParameters.Attributes() {
+		object, ok := value.(types.Object)
+		if ok && !object.IsNull() {
+			sourceType = attrName
+			sourceTypeParameters = object
+		}
+	}
+	if sourceType == "" {
+		diags.AddError("Unable to move sifflet_source state", "No parameters were found in the sifflet_source state")
+		return nullParameters, diags
+	}
+
+	targetType := sourceType
+	if t, ok := sourceV1ToV2Types[sourceType]; ok {
+		targetType = t
+	}
+	targetImpl, err := parameters.ParamsImplFromSchemaName(targetType)
+	if err != nil {
+		diags.AddError("Unable to move sifflet_source state", fmt.Sprintf("Source type %s is not supported by sifflet_source_v2: %s", sourceType, err))
+		return nullParameters, diags
+	}
+
+	sourceValues := sourceTypeParameters.Attributes()
+	targetTypes := targetImpl.AttributeTypes()
+	targetValues := make(map[string]attr.Value, len(targetTypes))
+	for attrName, attrType := range targetTypes {
+		switch {
+		case attrName == "credentials":
+			targetValues[attrName] = credentials
+		case attrName == "schedule":
+			targetValues[attrName] = schedule
+		case sourceValues[attrName] != nil && sourceValues[attrName].Type(ctx).Equal(attrType):
+			targetValues[attrName] = sourceValues[attrName]
+		default:
+			targetValues[attrName], err = attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+			if err != nil {
+				diags.AddError("Unable to move sifflet_source state", err.Error())
+				return nullParameters, diags
+			}
+		}
+	}
+	targetTypeParameters, objectDiags := types.ObjectValue(targetTypes, targetValues)
+	diags.Append(objectDiags...)
+	if diags.HasError() {
+		return nullParameters, diags
+	}
+
+	var droppedParameters []string
+	for attrName, value := range sourceValues {
+		if _, ok := targetTypes[attrName]; !ok && !value.IsNull() {
+			droppedParameters = append(droppedParameters, attrName)
+		}
+	}
+	if len(droppedParameters) > 0 {
+		slices.Sort(droppedParameters)
+		diags.AddWarning(
+			"sifflet_source parameters dropped",
+			fmt.Sprintf("The parameters %s of the sifflet_source resource don't exist in sifflet_source_v2, which manages the whole source. "+
+				"Move only one sifflet_source resource per source to sifflet_source_v2, and remove the other sifflet_source resources of the same source from the state "+
+				"(for instance with a removed block): otherwise, several resources manage the same source, and destroying one of them deletes the source of the others.",
+				strings.Join(droppedParameters, ", ")),
+		)
+	}
+
+	parametersTypes := parameters.ParametersModel{}.AttributeTypes()
+	parametersValues := make(map[string]attr.Value, len(parametersTypes))
+	for attrName, attrType := range parametersTypes {
+		if objectType, ok := attrType.(types.ObjectType); ok {
+			parametersValues[attrName] = types.ObjectNull(objectType.AttrTypes)
+		}
+	}
+	parametersValues[targetType] = targetTypeParameters
+	parametersValues["source_type"] = types.StringValue(targetType)
+
+	parametersObject, objectDiags := types.ObjectValue(parametersTypes, parametersValues)
+	diags.Append(objectDiags...)
+	return parametersObject, diags
+}
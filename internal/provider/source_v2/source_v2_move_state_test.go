@@ -0,0 +1,115 @@
+package source_v2
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"terraform-provider-sifflet/internal/provider/source"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// sourceV1Parameters returns the parameters attribute of a sifflet_source state, with the given values for the given source type.
+func sourceV1Parameters(t *testing.T, ctx context.Context, sourceType string, values map[string]attr.Value) types.Object {
+	sourceSchema := source.SourceResourceSchema(ctx)
+	parametersType, ok := sourceSchema.Attributes["parameters"].GetType().(types.ObjectType)
+	if !ok {
+		t.Fatalf("Unexpected type for sifflet_source parameters: %T", sourceSchema.Attributes["parameters"].GetType())
+	}
+
+	nullValue := func(attrType attr.Type) attr.Value {
+		value, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+		if err != nil {
+			t.Fatalf("Failed to create null value: %v", err)
+		}
+		return value
+	}
+
+	parametersValues := make(map[string]attr.Value, len(parametersType.AttrTypes))
+	for attrName, attrType := range parametersType.AttrTypes {
+		parametersValues[attrName] = nullValue(attrType)
+	}
+	sourceTypeType, ok := parametersType.AttrTypes[sourceType].(types.ObjectType)
+	if !ok {
+		t.Fatalf("Unknown sifflet_source type %s", sourceType)
+	}
+	sourceTypeValues := make(map[string]attr.Value, len(sourceTypeType.AttrTypes))
+	for attrName, attrType := range sourceTypeType.AttrTypes {
+		sourceTypeValues[attrName] = nullValue(attrType)
+		if value, ok := values[attrName]; ok {
+			sourceTypeValues[attrName] = value
+		}
+	}
+
+	var diags diag.Diagnostics
+	parametersValues[sourceType], diags = types.ObjectValue(sourceTypeType.AttrTypes, sourceTypeValues)
+	if diags.HasError() {
+		t.Fatalf("Failed to create %s parameters: %v", sourceType, diags)
+	}
+	parameters, diags := types.ObjectValue(parametersType.AttrTypes, parametersValues)
+	if diags.HasError() {
+		t.Fatalf("Failed to create parameters: %v", diags)
+	}
+	return parameters
+}
+
+func TestParametersFromSourceV1(t *testing.T) {
+	ctx := context.Background()
+	credentials := types.StringValue("credentials")
+	schedule := types.StringNull()
+
+	t.Run("no_dropped_parameters", func(t *testing.T) {
+		sourceParameters := sourceV1Parameters(t, ctx, "mysql", map[string]attr.Value{
+			"host":              types.StringValue("mysql"),
+			"port":              types.Int32Value(3306),
+			"database":          types.StringValue("database"),
+			"mysql_tls_version": types.StringValue("TLS_V_1_2"),
+		})
+
+		parameters, diags := parametersFromSourceV1(ctx, sourceParameters, credentials, schedule)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+		if diags.WarningsCount() != 0 {
+			t.Errorf("Unexpected warnings: %v", diags)
+		}
+		if !parameters.Attributes()["source_type"].Equal(types.StringValue("mysql")) {
+			t.Errorf("Unexpected source type: %s", parameters.Attributes()["source_type"])
+		}
+	})
+
+	t.Run("dropped_parameters", func(t *testing.T) {
+		sourceParameters := sourceV1Parameters(t, ctx, "postgresql", map[string]attr.Value{
+			"host":     types.StringValue("postgresql"),
+			"port":     types.Int32Value(5432),
+			"database": types.StringValue("database"),
+			"schema":   types.StringValue("schema"),
+		})
+
+		parameters, diags := parametersFromSourceV1(ctx, sourceParameters, credentials, schedule)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+		if diags.WarningsCount() != 1 {
+			t.Fatalf("Expected a warning about dropped parameters, got: %v", diags)
+		}
+		if detail := diags.Warnings()[0].Detail(); !strings.Contains(detail, "The parameters schema of the sifflet_source resource") {
+			t.Errorf("Unexpected warning: %s", detail)
+		}
+
+		postgresqlParameters, ok := parameters.Attributes()["postgresql"].(types.Object)
+		if !ok {
+			t.Fatalf("Unexpected postgresql parameters: %v", parameters.Attributes()["postgresql"])
+		}
+		if !postgresqlParameters.Attributes()["database"].Equal(types.StringValue("database")) {
+			t.Errorf("Expected the database to be kept, got %s", postgresqlParameters.Attributes()["database"])
+		}
+		if !postgresqlParameters.Attributes()["credentials"].Equal(credentials) {
+			t.Errorf("Expected the credentials to be moved, got %s", postgresqlParameters.Attributes()["credentials"])
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"terraform-provider-sifflet/internal/apiclients"
 	sifflet "terraform-provider-sifflet/internal/client"
@@ -12,18 +13,21 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource               = &sourceV2Resource{}
-	_ resource.ResourceWithConfigure  = &sourceV2Resource{}
-	_ resource.ResourceWithModifyPlan = &sourceV2Resource{}
+	_ resource.Resource                   = &sourceV2Resource{}
+	_ resource.ResourceWithConfigure      = &sourceV2Resource{}
+	_ resource.ResourceWithModifyPlan     = &sourceV2Resource{}
+	_ resource.ResourceWithValidateConfig = &sourceV2Resource{}
 )
 
 // ModifyPlan sets the computed source_type attribute based on the parameters
@@ -83,7 +87,7 @@ The sifflet_source_v2 resource will create a source including all assets associa
 
 ~> Consider adding a ` + "`lifecycle { prevent_destroy = true }` to `sifflet_source_v2`" + ` resources once they are correctly configured. Deleting a source deletes all associated data, including monitors on that source.
 
-~> When migrating from sifflet_source to sifflet_source_v2, keep in mind that multiple sifflet_source resources can correspond to a single sifflet_source_v2 resource. As such, we recommend removing the sifflet_source resources from your state, and importing the sifflet_source_v2 resources, by looking up their ID in the UI.
+~> When migrating from sifflet_source to sifflet_source_v2, keep in mind that multiple sifflet_source resources can correspond to a single sifflet_source_v2 resource. A sifflet_source resource can be moved to a sifflet_source_v2 resource with a moved block, when it's the only sifflet_source resource of its source. The parameters that only exist in sifflet_source (such as the database and schema of warehouse sources) are dropped from the moved state, with a warning: move only one sifflet_source resource per source, and remove the other sifflet_source resources of the same source from the state, otherwise several resources manage the same source and destroying one of them deletes the source of the others. The ID of a sifflet_source resource is not the ID of the source, so the provider looks up the source during the next refresh, by type and connection parameters; if no single source matches, the refresh fails. Otherwise, we recommend removing the sifflet_source resources from your state, and importing the sifflet_source_v2 resources, by looking up their ID in the UI.
 		`,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -105,6 +109,26 @@ The sifflet_source_v2 resource will create a source including all assets associa
 				Required:    true,
 			},
 			"parameters": parameters.ParametersModel{}.TerraformSchema(),
+			"validate_connection": schema.BoolAttribute{
+				Description: "Whether to test the connection to the source with the planned parameters and credentials before creating or updating it. " +
+					"When the test fails, the source is not created or updated. Only supported for airflow, dbt, fivetran, looker, mysql, quicksight and tableau sources. Defaults to false.",
+				Optional: true,
+			},
+			"wait_for_ingestion": schema.BoolAttribute{
+				Description: "Whether to run the ingestion of the source right after creating it, and to wait until this run completes. " +
+					"This makes the assets of the source available to other resources and data sources in the same apply. " +
+					"The wait is bounded by the create timeout (see timeouts), which should be increased accordingly. " +
+					"A failed or timed out ingestion run is reported as a warning. Defaults to false.",
+				Optional: true,
+			},
+			"last_run_status": schema.StringAttribute{
+				Description: "Status of the last ingestion run of the source (RUNNING, SUCCESS or FAILURE). Null if the source was never run.",
+				Computed:    true,
+			},
+			"last_run_timestamp": schema.StringAttribute{
+				Description: "Start time of the last ingestion run of the source, in RFC 3339 format. Null if the source was never run.",
+				Computed:    true,
+			},
 		},
 	}
 
@@ -139,6 +163,14 @@ func (r *sourceV2Resource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	if plan.ValidateConnection.ValueBool() {
+		diags = testSourceConnection(ctx, r.client, sourceBody, "Source connection test failed")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	requestBody := sifflet.PublicCreateSourceV2JSONRequestBody(sourceBody)
 	sourceResponse, err := r.client.PublicCreateSourceV2WithResponse(ctx, requestBody)
 	if err != nil {
@@ -165,8 +197,22 @@ func (r *sourceV2Resource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	newState.ValidateConnection = plan.ValidateConnection
+	newState.WaitForIngestion = plan.WaitForIngestion
 	newState.Timeouts = plan.Timeouts
 
+	if plan.WaitForIngestion.ValueBool() {
+		lastRun, diags := r.runFirstIngestion(ctx, newState)
+		// The source was created: reporting errors here would taint the resource, and replacing a source deletes all
+		// associated data. Report them as warnings instead.
+		for _, d := range diags {
+			resp.Diagnostics.AddWarning(d.Summary(), d.Detail())
+		}
+		if lastRun != nil {
+			newState.setLastRun(lastRun)
+		}
+	}
+
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -174,6 +220,37 @@ func (r *sourceV2Resource) Create(ctx context.Context, req resource.CreateReques
 	}
 }
 
+// runFirstIngestion triggers the ingestion of a newly created source, and waits until it completes.
+// The returned run is nil if the run could not be awaited.
+func (r *sourceV2Resource) runFirstIngestion(ctx context.Context, state sourceV2Model) (*sifflet.PublicGetLastRunV2Dto, diag.Diagnostics) {
+	id, diags := state.ModelId()
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	diags = triggerSourceIngestion(ctx, r.client, id, "Unable to run source ingestion")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	progress := func(message string) {
+		tflog.Info(ctx, message)
+	}
+	// The source may already have been run by its schedule: any completed run is fine, since the source didn't exist before.
+	lastRun, diags := waitForSourceIngestion(ctx, r.client, id, nil, defaultIngestionRunPollInterval, progress, "Unable to wait for source ingestion")
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if *lastRun.Status == sifflet.PublicGetLastRunV2DtoStatusFAILURE {
+		diags.AddError(
+			"Source ingestion run failed",
+			fmt.Sprintf("The first ingestion run of source %s failed. Check the source in the Sifflet UI for details.", id),
+		)
+	}
+	return lastRun, diags
+}
+
 func (r *sourceV2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// No default timeout, this resource implements its own timeouts.
 
@@ -199,6 +276,20 @@ func (r *sourceV2Resource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	movedFromSourceV1, diags := req.Private.GetKey(ctx, privateKeyMovedFromSourceV1)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if movedFromSourceV1 != nil {
+		id, diags = resolveMovedSourceId(ctx, r.client, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyMovedFromSourceV1, nil)...)
+	}
+
 	res, err := r.client.PublicGetSourceV2WithResponse(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read source: could not parse API response", err.Error())
@@ -223,6 +314,8 @@ func (r *sourceV2Resource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	newState.ValidateConnection = state.ValidateConnection
+	newState.WaitForIngestion = state.WaitForIngestion
 	newState.Timeouts = state.Timeouts
 
 	diags = resp.State.Set(ctx, newState)
@@ -257,6 +350,19 @@ func (r *sourceV2Resource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	if plan.ValidateConnection.ValueBool() {
+		testBody, diags := plan.ToCreateDto(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		diags = testSourceConnection(ctx, r.client, testBody, "Source connection test failed")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	body, diags := plan.ToUpdateDto(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -287,6 +393,8 @@ func (r *sourceV2Resource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	newState.ValidateConnection = plan.ValidateConnection
+	newState.WaitForIngestion = plan.WaitForIngestion
 	newState.Timeouts = plan.Timeouts
 
 	diags = resp.State.Set(ctx, newState)
@@ -359,6 +467,39 @@ func (r *sourceV2Resource) Configure(_ context.Context, req resource.ConfigureRe
 	r.client = clients.Client
 }
 
+// ValidateConfig rejects validate_connection for source types whose connection can't be tested,
+// so that the configuration fails at plan time rather than during the apply.
+func (r sourceV2Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config sourceV2Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ValidateConnection.ValueBool() || config.Parameters.IsNull() || config.Parameters.IsUnknown() {
+		return
+	}
+
+	var parametersModel parameters.ParametersModel
+	diags := config.Parameters.As(ctx, &parametersModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return
+	}
+	sourceType, diags := parametersModel.GetSourceParameters(ctx)
+	if diags.HasError() {
+		// Invalid parameters are reported by ConfigValidators.
+		return
+	}
+
+	if !sifflet.SupportsTestConnection(strings.ToUpper(sourceType.SchemaSourceType())) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validate_connection"),
+			"Connection validation not supported",
+			fmt.Sprintf("Testing the connection of %s sources is not supported. Remove validate_connection or set it to false.", sourceType.SchemaSourceType()),
+		)
+	}
+}
+
 // ConfigValidators ensures exactly one source type is configured in parameters
 // This prevents users from accidentally configuring multiple source types
 // which would result in ambiguous behavior.
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func init() {
@@ -235,6 +236,104 @@ func TestAccSourceInvalidConfigV2(t *testing.T) {
 	})
 }
 
+func TestAccSourceV2ValidateConnection(t *testing.T) {
+	sourceName := randomSourceName()
+	host := providertests.RandomName()
+	credName := providertests.RandomCredentialsName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The host doesn't exist, so the connection test fails and the source is not created
+			{
+				Config: baseConfig(credName) + fmt.Sprintf(`
+					resource "sifflet_source_v2" "test" {
+						name = "%s"
+						validate_connection = true
+						parameters = {
+							mysql = {
+								host = "%s.invalid"
+								port = "3306"
+								database = "database"
+								mysql_tls_version = "TLS_V_1_2"
+								credentials = sifflet_credentials.test.name
+							}
+						}
+					}
+					`, sourceName, host),
+				ExpectError: regexp.MustCompile("Source connection test failed"),
+			},
+			// Source types that can't be tested are rejected at plan time
+			{
+				PlanOnly: true,
+				Config: baseConfig(credName) + fmt.Sprintf(`
+					resource "sifflet_source_v2" "test" {
+						name = "%s"
+						validate_connection = true
+						parameters = {
+							qlik = {
+								host = "%s.invalid"
+								credentials = sifflet_credentials.test.name
+							}
+						}
+					}
+					`, sourceName, host),
+				ExpectError: regexp.MustCompile("Testing the connection of qlik sources is not supported"),
+			},
+			{
+				PlanOnly: true,
+				Config: baseConfig(credName) + fmt.Sprintf(`
+					resource "sifflet_source_v2" "test" {
+						name = "%s"
+						validate_connection = true
+						parameters = {
+							snowflake = {
+								account_identifier = "%s"
+								warehouse = "warehouse"
+								credentials = sifflet_credentials.test.name
+							}
+						}
+					}
+					`, sourceName, host),
+				ExpectError: regexp.MustCompile("Testing the connection of snowflake sources is not supported"),
+			},
+		},
+	})
+}
+
+func TestAccSourceV2WaitForIngestion(t *testing.T) {
+	sourceName := randomSourceName()
+	project := providertests.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_source_v2" "test" {
+						name = "%s"
+						wait_for_ingestion = true
+						parameters = {
+							dbt = {
+								target = "target"
+								project_name = "%s"
+							}
+						}
+						timeouts = {
+							create = "15m"
+						}
+					}
+					`, sourceName, project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "wait_for_ingestion", "true"),
+					resource.TestMatchResourceAttr("sifflet_source_v2.test", "last_run_status", regexp.MustCompile("^(SUCCESS|FAILURE)$")),
+					resource.TestCheckResourceAttrSet("sifflet_source_v2.test", "last_run_timestamp"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSourceParamsV2(t *testing.T) {
 	sourceName := randomSourceName()
 	project := providertests.RandomName()
@@ -243,7 +342,9 @@ func TestAccSourceParamsV2(t *testing.T) {
 	clientId := providertests.RandomName()
 	accountIdentifier := providertests.RandomName()
 	athenaDatasource := providertests.RandomName()
+	factoryName := providertests.RandomName()
 	credName := providertests.RandomCredentialsName()
+	updatedHost := providertests.RandomName()
 
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
@@ -266,6 +367,32 @@ func TestAccSourceParamsV2(t *testing.T) {
 					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.dbt.project_name", project),
 				),
 			},
+			{
+				Config: baseConfig(credName) + fmt.Sprintf(`
+			resource "sifflet_source_v2" "test" {
+				name = "%s-adf"
+				parameters = {
+					adf = {
+						tenant_id = "00000000-0000-0000-0000-000000000000"
+						subscription_id = "00000000-0000-0000-0000-000000000000"
+						resource_group = "resource_group"
+						factory_name = "%s"
+						credentials = sifflet_credentials.test.name
+					}
+				}
+			}
+			`, sourceName, factoryName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "name", fmt.Sprintf("%s-adf", sourceName)),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.source_type", "adf"),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.adf.factory_name", factoryName),
+				),
+			},
+			{
+				ResourceName:      "sifflet_source_v2.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: baseConfig(credName) + fmt.Sprintf(`
 			resource "sifflet_source_v2" "test" {
@@ -326,6 +453,31 @@ func TestAccSourceParamsV2(t *testing.T) {
 					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.databricks.host", host),
 				),
 			},
+			{
+				Config: baseConfig(credName) + fmt.Sprintf(`
+			resource "sifflet_source_v2" "test" {
+				name = "%s-databricks-jobs"
+				parameters = {
+					databricks_jobs = {
+						host = "%s"
+						http_path = "http_path"
+						port = 443
+						credentials = sifflet_credentials.test.name
+					}
+				}
+			}
+			`, sourceName, host),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "name", fmt.Sprintf("%s-databricks-jobs", sourceName)),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.source_type", "databricks_jobs"),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.databricks_jobs.host", host),
+				),
+			},
+			{
+				ResourceName:      "sifflet_source_v2.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: baseConfig(credName) + fmt.Sprintf(`
 			resource "sifflet_source_v2" "test" {
@@ -413,6 +565,50 @@ func TestAccSourceParamsV2(t *testing.T) {
 					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.looker.git_connections.0.url", "url"),
 				),
 			},
+			{
+				Config: baseConfig(credName) + fmt.Sprintf(`
+			resource "sifflet_source_v2" "test" {
+				name = "%s-microstrategy"
+				parameters = {
+					microstrategy = {
+						host = "%s"
+						credentials = sifflet_credentials.test.name
+					}
+				}
+			}
+			`, sourceName, host),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "name", fmt.Sprintf("%s-microstrategy", sourceName)),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.source_type", "microstrategy"),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.microstrategy.host", host),
+				),
+			},
+			{
+				Config: baseConfig(credName) + fmt.Sprintf(`
+			resource "sifflet_source_v2" "test" {
+				name = "%s-microstrategy"
+				parameters = {
+					microstrategy = {
+						host = "%s"
+						credentials = sifflet_credentials.test.name
+					}
+				}
+			}
+			`, sourceName, updatedHost),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sifflet_source_v2.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.microstrategy.host", updatedHost),
+				),
+			},
+			{
+				ResourceName:      "sifflet_source_v2.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: baseConfig(credName) + fmt.Sprintf(`
 			resource "sifflet_source_v2" "test" {
@@ -515,6 +711,50 @@ func TestAccSourceParamsV2(t *testing.T) {
 					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.power_bi.client_id", clientId),
 				),
 			},
+			{
+				Config: baseConfig(credName) + fmt.Sprintf(`
+			resource "sifflet_source_v2" "test" {
+				name = "%s-qlik"
+				parameters = {
+					qlik = {
+						host = "%s"
+						credentials = sifflet_credentials.test.name
+					}
+				}
+			}
+			`, sourceName, host),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "name", fmt.Sprintf("%s-qlik", sourceName)),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.source_type", "qlik"),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.qlik.host", host),
+				),
+			},
+			{
+				Config: baseConfig(credName) + fmt.Sprintf(`
+			resource "sifflet_source_v2" "test" {
+				name = "%s-qlik"
+				parameters = {
+					qlik = {
+						host = "%s"
+						credentials = sifflet_credentials.test.name
+					}
+				}
+			}
+			`, sourceName, updatedHost),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sifflet_source_v2.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.qlik.host", updatedHost),
+				),
+			},
+			{
+				ResourceName:      "sifflet_source_v2.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: baseConfig(credName) + fmt.Sprintf(`
 			resource "sifflet_source_v2" "test" {
@@ -668,3 +908,128 @@ func TestAccSourceV2Timeout(t *testing.T) {
 		},
 	})
 }
+
+func TestAccSourceV2MoveFromSourceV1(t *testing.T) {
+	sourceName := randomSourceName()
+	host := providertests.RandomName()
+	credName := providertests.RandomCredentialsName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: baseConfig(credName) + fmt.Sprintf(`
+					resource "sifflet_source" "test" {
+						name = "%s"
+						credentials = sifflet_credentials.test.name
+						parameters = {
+							mysql = {
+								host = "%s"
+								port = 3306
+								database = "database"
+								mysql_tls_version = "TLS_V_1_2"
+							}
+						}
+					}
+					`, sourceName, host),
+			},
+			{
+				Config: baseConfig(credName) + fmt.Sprintf(`
+					moved {
+						from = sifflet_source.test
+						to   = sifflet_source_v2.test
+					}
+
+					resource "sifflet_source_v2" "test" {
+						name = "%s"
+						parameters = {
+							mysql = {
+								host = "%s"
+								port = 3306
+								database = "database"
+								mysql_tls_version = "TLS_V_1_2"
+								credentials = sifflet_credentials.test.name
+							}
+						}
+					}
+					`, sourceName, host),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sifflet_source_v2.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "name", sourceName),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.source_type", "mysql"),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.mysql.host", host),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.mysql.credentials", credName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourceV2MoveFromSourceV1DroppedParameters(t *testing.T) {
+	// The schema of a postgresql sifflet_source is dropped from the moved state, with a warning.
+	sourceName := randomSourceName()
+	host := providertests.RandomName()
+	credName := providertests.RandomCredentialsName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: baseConfig(credName) + fmt.Sprintf(`
+					resource "sifflet_source" "test" {
+						name = "%s"
+						credentials = sifflet_credentials.test.name
+						parameters = {
+							postgresql = {
+								host = "%s"
+								port = 5432
+								database = "database"
+								schema = "schema"
+							}
+						}
+					}
+					`, sourceName, host),
+			},
+			{
+				Config: baseConfig(credName) + fmt.Sprintf(`
+					moved {
+						from = sifflet_source.test
+						to   = sifflet_source_v2.test
+					}
+
+					resource "sifflet_source_v2" "test" {
+						name = "%s"
+						parameters = {
+							postgresql = {
+								host = "%s"
+								port = 5432
+								database = "database"
+								credentials = sifflet_credentials.test.name
+							}
+						}
+					}
+					`, sourceName, host),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sifflet_source_v2.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.source_type", "postgresql"),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.postgresql.host", host),
+					resource.TestCheckResourceAttr("sifflet_source_v2.test", "parameters.postgresql.database", "database"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,285 @@
+package source_v2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	parameters "terraform-provider-sifflet/internal/provider/source_v2/parameters_v2"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ datasource.DataSource              = &sourcesV2DataSource{}
+	_ datasource.DataSourceWithConfigure = &sourcesV2DataSource{}
+)
+
+func newSourcesV2DataSource() datasource.DataSource {
+	return &sourcesV2DataSource{}
+}
+
+type sourcesV2DataSource struct {
+	client *sifflet.ClientWithResponses
+}
+
+func (d *sourcesV2DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Client
+}
+
+func (d *sourcesV2DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sources_v2"
+}
+
+func SourcesV2DataSourceSchema(ctx context.Context) schema.Schema {
+	sourceTypes := parameters.GetAllSourceTypes()
+	slices.Sort(sourceTypes)
+	lastRunStatuses := []string{
+		string(sifflet.PublicGetLastRunV2DtoStatusRUNNING),
+		string(sifflet.PublicGetLastRunV2DtoStatusSUCCESS),
+		string(sifflet.PublicGetLastRunV2DtoStatusFAILURE),
+	}
+
+	return schema.Schema{
+		Description: "Return the sources matching search criteria. Use the sifflet_source_v2 data source to read the connection parameters of a source.",
+		Attributes: map[string]schema.Attribute{
+			"max_results": schema.Int32Attribute{
+				Description: "Maximum number of results to return. Default is 1000.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Search criteria. Sources must match all the criteria that are set.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"text_search": schema.StringAttribute{
+						Description: "Return sources whose name contains this text (case-insensitive).",
+						Optional:    true,
+					},
+					"source_types": schema.ListAttribute{
+						Description: "List of source types to filter on, as used in the parameters of the sifflet_source_v2 resource (such as 'bigquery' or 'dbt').",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(sourceTypes...)),
+						},
+					},
+					"last_run_statuses": schema.ListAttribute{
+						Description: "List of last run statuses to filter on (RUNNING, SUCCESS or FAILURE). Sources that were never run don't match this filter.",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(lastRunStatuses...)),
+						},
+					},
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "List of sources returned by the search.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Source ID.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Source name.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Source type, as used in the parameters of the sifflet_source_v2 resource (such as 'bigquery' or 'dbt').",
+							Computed:    true,
+						},
+						"last_run_status": schema.StringAttribute{
+							Description: "Status of the last ingestion run of the source (RUNNING, SUCCESS or FAILURE). Null if the source was never run.",
+							Computed:    true,
+						},
+						"last_run_timestamp": schema.StringAttribute{
+							Description: "Start time of the last ingestion run of the source, in RFC 3339 format. Null if the source was never run.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *sourcesV2DataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = SourcesV2DataSourceSchema(ctx)
+}
+
+type sourcesV2DataSourceModel struct {
+	MaxResults types.Int32  `tfsdk:"max_results"`
+	Filter     types.Object `tfsdk:"filter"`
+	Results    types.List   `tfsdk:"results"`
+}
+
+type sourcesV2FilterModel struct {
+	TextSearch      types.String `tfsdk:"text_search"`
+	SourceTypes     types.List   `tfsdk:"source_types"`
+	LastRunStatuses types.List   `tfsdk:"last_run_statuses"`
+}
+
+type sourceSummaryModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	LastRunStatus    types.String `tfsdk:"last_run_status"`
+	LastRunTimestamp types.String `tfsdk:"last_run_timestamp"`
+}
+
+func (m sourceSummaryModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                 types.StringType,
+		"name":               types.StringType,
+		"type":               types.StringType,
+		"last_run_status":    types.StringType,
+		"last_run_timestamp": types.StringType,
+	}
+}
+
+func (m *sourceSummaryModel) FromDto(source sifflet.PublicGetSourceV2) {
+	m.Id = types.StringValue(source.GetId().String())
+	m.Name = types.StringValue(source.GetName())
+	m.Type = types.StringValue(strings.ToLower(source.GetType()))
+	m.LastRunStatus, m.LastRunTimestamp = lastRunValues(source.GetLastRun())
+}
+
+// matches returns true if the source matches all the criteria of the filter.
+func (m sourcesV2FilterModel) matches(ctx context.Context, source sourceSummaryModel) (bool, diag.Diagnostics) {
+	if !m.TextSearch.IsNull() && !strings.Contains(strings.ToLower(source.Name.ValueString()), strings.ToLower(m.TextSearch.ValueString())) {
+		return false, diag.Diagnostics{}
+	}
+
+	if !m.SourceTypes.IsNull() {
+		var sourceTypes []string
+		diags := m.SourceTypes.ElementsAs(ctx, &sourceTypes, false)
+		if diags.HasError() {
+			return false, diags
+		}
+		if !slices.Contains(sourceTypes, source.Type.ValueString()) {
+			return false, diag.Diagnostics{}
+		}
+	}
+
+	if !m.LastRunStatuses.IsNull() {
+		var statuses []string
+		diags := m.LastRunStatuses.ElementsAs(ctx, &statuses, false)
+		if diags.HasError() {
+			return false, diags
+		}
+		if source.LastRunStatus.IsNull() || !slices.Contains(statuses, source.LastRunStatus.ValueString()) {
+			return false, diag.Diagnostics{}
+		}
+	}
+
+	return true, diag.Diagnostics{}
+}
+
+func (d *sourcesV2DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var data sourcesV2DataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter sourcesV2FilterModel
+	if !data.Filter.IsNull() {
+		diags = data.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		filter = sourcesV2FilterModel{
+			TextSearch:      types.StringNull(),
+			SourceTypes:     types.ListNull(types.StringType),
+			LastRunStatuses: types.ListNull(types.StringType),
+		}
+	}
+
+	maxResults := int(data.MaxResults.ValueInt32())
+	if maxResults == 0 {
+		// Set a default
+		maxResults = 1000
+	}
+
+	// The API returns all the sources at once, and doesn't support filtering: filters are applied here.
+	sources, diags := ListSources(ctx, d.client, "Unable to list sources")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results := make([]sourceSummaryModel, 0)
+	for _, source := range sources {
+		if len(results) >= maxResults {
+			break
+		}
+
+		sourceDto, err := source.GetSourceDto()
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read source", err.Error())
+			return
+		}
+
+		var summary sourceSummaryModel
+		summary.FromDto(sourceDto)
+
+		match, diags := filter.matches(ctx, summary)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if match {
+			results = append(results, summary)
+		}
+	}
+
+	data.Results, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: sourceSummaryModel{}.AttributeTypes()}, results)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,82 @@
+package source_v2_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSourcesV2DataSource(t *testing.T) {
+	sourceName := randomSourceName()
+	project := providertests.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_source_v2" "test" {
+						name = "%s"
+						parameters = {
+							dbt = {
+								target = "target"
+								project_name = "%s"
+							}
+						}
+					}
+
+					data "sifflet_sources_v2" "by_name" {
+						filter = {
+							text_search = upper(sifflet_source_v2.test.name)
+							source_types = ["dbt"]
+						}
+					}
+
+					data "sifflet_sources_v2" "other_type" {
+						filter = {
+							text_search = sifflet_source_v2.test.name
+							source_types = ["bigquery"]
+						}
+					}
+				`, sourceName, project),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sifflet_sources_v2.by_name", "results.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sifflet_sources_v2.by_name", "results.0.id", "sifflet_source_v2.test", "id"),
+					resource.TestCheckResourceAttr("data.sifflet_sources_v2.by_name", "results.0.name", sourceName),
+					resource.TestCheckResourceAttr("data.sifflet_sources_v2.by_name", "results.0.type", "dbt"),
+					resource.TestCheckResourceAttr("data.sifflet_sources_v2.other_type", "results.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSourcesV2DataSourceInvalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_sources_v2" "test" {
+					filter = {
+						source_types = ["not_a_source_type"]
+					}
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_sources_v2" "test" {
+					filter = {
+						last_run_statuses = ["DONE"]
+					}
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
@@ -57,7 +57,7 @@ func (r *teamResource) Schema(ctx context.Context, _ resource.SchemaRequest, res
 				Optional:    true,
 			},
 			"domain_permissions": schema.SetNestedAttribute{
-				Description: "Domain permissions granted to the team.",
+				Description: "Domain permissions granted to the team. Don't manage the permissions of the team in a given domain both here and in the team_permissions of a sifflet_domain resource.",
 				Optional:    true,
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
@@ -0,0 +1,77 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// getUser reads a user by ID.
+func getUser(ctx context.Context, client *sifflet.ClientWithResponses, id uuid.UUID, summary string) (sifflet.PublicUserGetDto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	userResponse, err := client.PublicGetUserWithResponse(ctx, id)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return sifflet.PublicUserGetDto{}, diags
+	}
+
+	if userResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, userResponse.StatusCode(), userResponse.Body)
+		return sifflet.PublicUserGetDto{}, diags
+	}
+
+	return *userResponse.JSON200, diags
+}
+
+// listUsers returns all the users, going through all the pages of results.
+func listUsers(ctx context.Context, client *sifflet.ClientWithResponses, summary string) ([]sifflet.PublicUserGetDto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	users := make([]sifflet.PublicUserGetDto, 0)
+	var itemsPerPage int32 = 100
+	for page := int32(0); ; page++ {
+		usersResponse, err := client.PublicGetUsersWithResponse(ctx, &sifflet.PublicGetUsersParams{
+			Page:         &page,
+			ItemsPerPage: &itemsPerPage,
+		})
+		if err != nil {
+			diags.AddError(summary, err.Error())
+			return nil, diags
+		}
+
+		if usersResponse.StatusCode() != http.StatusOK {
+			sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, usersResponse.StatusCode(), usersResponse.Body)
+			return nil, diags
+		}
+
+		users = append(users, usersResponse.JSON200.Data...)
+		if int32(len(usersResponse.JSON200.Data)) < itemsPerPage { // nolint: gosec
+			// Last page
+			return users, diags
+		}
+	}
+}
+
+// findUserByEmail returns the user with the given email. Emails are compared case-insensitively.
+func findUserByEmail(ctx context.Context, client *sifflet.ClientWithResponses, email string, summary string) (sifflet.PublicUserGetDto, diag.Diagnostics) {
+	users, diags := listUsers(ctx, client, summary)
+	if diags.HasError() {
+		return sifflet.PublicUserGetDto{}, diags
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			return user, diags
+		}
+	}
+
+	diags.AddError(summary, fmt.Sprintf("No user with email %q was found", email))
+	return sifflet.PublicUserGetDto{}, diags
+}
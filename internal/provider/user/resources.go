@@ -12,5 +12,8 @@ func Resources() []func() resource.Resource {
 }
 
 func DataSources() []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		newUserDataSource,
+		newUsersDataSource,
+	}
 }
@@ -0,0 +1,239 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/model"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = &userDataSource{}
+	_ datasource.DataSourceWithConfigure        = &userDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &userDataSource{}
+)
+
+func newUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+type userDataSource struct {
+	client *sifflet.ClientWithResponses
+}
+
+func (d *userDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Client
+}
+
+func (d *userDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// userDataSourceAttributes returns the attributes describing a user, shared by the sifflet_user and sifflet_users data sources.
+func userDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "User ID.",
+			Computed:    true,
+		},
+		"email": schema.StringAttribute{
+			Description: "User email.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "User full name.",
+			Computed:    true,
+		},
+		"role": schema.StringAttribute{
+			Description: "User system role. One of 'ADMIN', 'EDITOR', 'VIEWER'.",
+			Computed:    true,
+		},
+		"status": schema.StringAttribute{
+			Description: "User status. One of 'ENABLED', 'DISABLED'.",
+			Computed:    true,
+		},
+		"auth_types": schema.SetAttribute{
+			Description: "Authorized authentication types for the user ('SAML2' or 'LOGIN_PASSWORD').",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"team_ids": schema.SetAttribute{
+			Description: "IDs of the teams the user belongs to.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"permissions": schema.SetNestedAttribute{
+			Description: "Per-domain user permissions. ADMIN users are granted editor access on all domains.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"domain_id": schema.StringAttribute{
+						Description: "Domain ID.",
+						Computed:    true,
+					},
+					"domain_role": schema.StringAttribute{
+						Description: "User role in the domain. One of 'EDITOR', 'VIEWER', 'CATALOG_EDITOR', 'MONITOR_RESPONDER'.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func UserDataSourceSchema(ctx context.Context) schema.Schema {
+	attributes := userDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "User ID. Either id or email must be set.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["email"] = schema.StringAttribute{
+		Description: "User email (case-insensitive). Either id or email must be set.",
+		Optional:    true,
+		Computed:    true,
+	}
+
+	return schema.Schema{
+		Description: "Read a Sifflet user by its ID or by its email. This includes users created by SSO just-in-time provisioning, which aren't managed by Terraform.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *userDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = UserDataSourceSchema(ctx)
+}
+
+func (d *userDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("email"),
+		),
+	}
+}
+
+type userDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Email       types.String `tfsdk:"email"`
+	Name        types.String `tfsdk:"name"`
+	Role        types.String `tfsdk:"role"`
+	Status      types.String `tfsdk:"status"`
+	AuthTypes   types.Set    `tfsdk:"auth_types"`
+	TeamIds     types.Set    `tfsdk:"team_ids"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+func (m userDataSourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"email":       types.StringType,
+		"name":        types.StringType,
+		"role":        types.StringType,
+		"status":      types.StringType,
+		"auth_types":  types.SetType{ElemType: types.StringType},
+		"team_ids":    types.SetType{ElemType: types.StringType},
+		"permissions": types.SetType{ElemType: types.ObjectType{AttrTypes: permissionModel{}.AttributeTypes()}},
+	}
+}
+
+func (m *userDataSourceModel) FromDto(ctx context.Context, userDto sifflet.PublicUserGetDto) diag.Diagnostics {
+	permissions, diags := model.NewModelSetFromDto(
+		ctx, userDto.Permissions,
+		func() model.InnerModel[sifflet.PublicUserPermissionAssignmentDto] { return &permissionModel{} },
+	)
+	if diags.HasError() {
+		return diags
+	}
+
+	authTypes, diags := types.SetValueFrom(ctx, types.StringType, userDto.AuthTypes)
+	if diags.HasError() {
+		return diags
+	}
+
+	teamIdsSlice := make([]string, len(userDto.Teams))
+	for i, team := range userDto.Teams {
+		teamIdsSlice[i] = team.TeamId.String()
+	}
+	teamIds, diags := types.SetValueFrom(ctx, types.StringType, teamIdsSlice)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.Id = types.StringValue(userDto.Id.String())
+	m.Email = types.StringValue(userDto.Email)
+	m.Name = types.StringValue(userDto.Name)
+	m.Role = types.StringValue(string(userDto.Role))
+	m.Status = types.StringValue(string(userDto.Status))
+	m.AuthTypes = authTypes
+	m.TeamIds = teamIds
+	m.Permissions = permissions
+	return diag.Diagnostics{}
+}
+
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var data userDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userDto sifflet.PublicUserGetDto
+	if !data.Email.IsNull() {
+		dto, diags := findUserByEmail(ctx, d.client, data.Email.ValueString(), "Unable to read user")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		userDto = dto
+	} else {
+		id, err := uuid.Parse(data.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Could not parse user ID as UUID", err.Error())
+			return
+		}
+
+		dto, diags := getUser(ctx, d.client, id, "Unable to read user")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		userDto = dto
+	}
+
+	resp.Diagnostics.Append(data.FromDto(ctx, userDto)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,79 @@
+package user_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDataSource(t *testing.T) {
+	userEmail := providertests.RandomEmail()
+	domainId := "aaaabbbb-aaaa-bbbb-aaaa-bbbbaaaabbbb"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+						resource "sifflet_user" "test" {
+							email = "%s"
+							name = "Terraform Test User"
+							role = "VIEWER"
+							permissions = [{
+								domain_id = "%s"
+								domain_role = "VIEWER"
+							}]
+						}
+
+						data "sifflet_user" "by_id" {
+							id = sifflet_user.test.id
+						}
+
+						data "sifflet_user" "by_email" {
+							email = upper(sifflet_user.test.email)
+						}
+						`, userEmail, domainId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.sifflet_user.by_id", "email", "sifflet_user.test", "email"),
+					resource.TestCheckResourceAttr("data.sifflet_user.by_id", "name", "Terraform Test User"),
+					resource.TestCheckResourceAttr("data.sifflet_user.by_id", "role", "VIEWER"),
+					resource.TestCheckResourceAttr("data.sifflet_user.by_id", "status", "ENABLED"),
+					resource.TestCheckResourceAttr("data.sifflet_user.by_id", "team_ids.#", "0"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sifflet_user.by_id", "permissions.*", map[string]string{
+						"domain_id":   domainId,
+						"domain_role": "VIEWER",
+					}),
+					resource.TestCheckResourceAttrPair("data.sifflet_user.by_email", "id", "sifflet_user.test", "id"),
+					resource.TestCheckResourceAttr("data.sifflet_user.by_email", "email", userEmail),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSourceError(t *testing.T) {
+	email := providertests.RandomEmail()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+				data "sifflet_user" "test" {
+					email = "%s"
+				}`, email),
+				ExpectError: regexp.MustCompile("No user with email"),
+			},
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_user" "test" {
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
@@ -0,0 +1,245 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+func newUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+type usersDataSource struct {
+	client *sifflet.ClientWithResponses
+}
+
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.Client
+}
+
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func UsersDataSourceSchema(ctx context.Context) schema.Schema {
+	roles := []string{
+		string(sifflet.PublicUserGetDtoRoleADMIN),
+		string(sifflet.PublicUserGetDtoRoleEDITOR),
+		string(sifflet.PublicUserGetDtoRoleVIEWER),
+	}
+	statuses := []string{
+		string(sifflet.ENABLED),
+		string(sifflet.DISABLED),
+	}
+
+	return schema.Schema{
+		Description: "Return the users matching search criteria. This includes users created by SSO just-in-time provisioning, which aren't managed by Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"max_results": schema.Int32Attribute{
+				Description: "Maximum number of results to return. Default is 1000.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Search criteria. Users must match all the criteria that are set.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"roles": schema.ListAttribute{
+						Description: "List of system roles to filter on (ADMIN, EDITOR or VIEWER).",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(roles...)),
+						},
+					},
+					"team_ids": schema.ListAttribute{
+						Description: "List of team IDs to filter on. Users must belong to at least one of these teams.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"statuses": schema.ListAttribute{
+						Description: "List of user statuses to filter on (ENABLED or DISABLED).",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(stringvalidator.OneOf(statuses...)),
+						},
+					},
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Description: "List of users returned by the search.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *usersDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = UsersDataSourceSchema(ctx)
+}
+
+type usersDataSourceModel struct {
+	MaxResults types.Int32  `tfsdk:"max_results"`
+	Filter     types.Object `tfsdk:"filter"`
+	Results    types.List   `tfsdk:"results"`
+}
+
+type usersFilterModel struct {
+	Roles    types.List `tfsdk:"roles"`
+	TeamIds  types.List `tfsdk:"team_ids"`
+	Statuses types.List `tfsdk:"statuses"`
+}
+
+// matches returns true if the user matches all the criteria of the filter.
+func (m usersFilterModel) matches(ctx context.Context, user sifflet.PublicUserGetDto) (bool, diag.Diagnostics) {
+	if !m.Roles.IsNull() {
+		var roles []string
+		diags := m.Roles.ElementsAs(ctx, &roles, false)
+		if diags.HasError() {
+			return false, diags
+		}
+		if !slices.Contains(roles, string(user.Role)) {
+			return false, diag.Diagnostics{}
+		}
+	}
+
+	if !m.Statuses.IsNull() {
+		var statuses []string
+		diags := m.Statuses.ElementsAs(ctx, &statuses, false)
+		if diags.HasError() {
+			return false, diags
+		}
+		if !slices.Contains(statuses, string(user.Status)) {
+			return false, diag.Diagnostics{}
+		}
+	}
+
+	if !m.TeamIds.IsNull() {
+		var teamIds []string
+		diags := m.TeamIds.ElementsAs(ctx, &teamIds, false)
+		if diags.HasError() {
+			return false, diags
+		}
+		inTeam := slices.ContainsFunc(user.Teams, func(team sifflet.PublicUserTeamDto) bool {
+			return slices.Contains(teamIds, team.TeamId.String())
+		})
+		if !inTeam {
+			return false, diag.Diagnostics{}
+		}
+	}
+
+	return true, diag.Diagnostics{}
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var data usersDataSourceModel
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := usersFilterModel{
+		Roles:    types.ListNull(types.StringType),
+		TeamIds:  types.ListNull(types.StringType),
+		Statuses: types.ListNull(types.StringType),
+	}
+	if !data.Filter.IsNull() {
+		diags = data.Filter.As(ctx, &filter, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	maxResults := int(data.MaxResults.ValueInt32())
+	if maxResults == 0 {
+		// Set a default
+		maxResults = 1000
+	}
+
+	// The API doesn't support filtering users: filters are applied here.
+	users, diags := listUsers(ctx, d.client, "Unable to list users")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results := make([]userDataSourceModel, 0)
+	for _, user := range users {
+		if len(results) >= maxResults {
+			break
+		}
+
+		match, diags := filter.matches(ctx, user)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !match {
+			continue
+		}
+
+		var result userDataSourceModel
+		resp.Diagnostics.Append(result.FromDto(ctx, user)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		results = append(results, result)
+	}
+
+	data.Results, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: userDataSourceModel{}.AttributeTypes()}, results)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
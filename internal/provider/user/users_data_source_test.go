@@ -0,0 +1,91 @@
+package user_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUsersDataSource(t *testing.T) {
+	userEmail := providertests.RandomEmail()
+	teamName := providertests.RandomName()
+	domainId := "aaaabbbb-aaaa-bbbb-aaaa-bbbbaaaabbbb"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+						resource "sifflet_user" "test" {
+							email = "%s"
+							name = "Terraform Test User"
+							role = "EDITOR"
+							permissions = [{
+								domain_id = "%s"
+								domain_role = "EDITOR"
+							}]
+						}
+
+						resource "sifflet_team" "test" {
+							name = "%s"
+							users = [{
+								user_id = sifflet_user.test.id
+							}]
+						}
+
+						data "sifflet_users" "in_team" {
+							filter = {
+								team_ids = [sifflet_team.test.id]
+								roles = ["EDITOR"]
+								statuses = ["ENABLED"]
+							}
+						}
+
+						data "sifflet_users" "other_role" {
+							filter = {
+								team_ids = [sifflet_team.test.id]
+								roles = ["ADMIN"]
+							}
+						}
+						`, userEmail, domainId, teamName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sifflet_users.in_team", "results.#", "1"),
+					resource.TestCheckResourceAttrPair("data.sifflet_users.in_team", "results.0.id", "sifflet_user.test", "id"),
+					resource.TestCheckResourceAttr("data.sifflet_users.in_team", "results.0.email", userEmail),
+					resource.TestCheckTypeSetElemAttrPair("data.sifflet_users.in_team", "results.0.team_ids.*", "sifflet_team.test", "id"),
+					resource.TestCheckResourceAttr("data.sifflet_users.other_role", "results.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUsersDataSourceInvalidFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_users" "test" {
+					filter = {
+						roles = ["OWNER"]
+					}
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: providertests.ProviderConfig() + `
+				data "sifflet_users" "test" {
+					filter = {
+						statuses = ["ACTIVE"]
+					}
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
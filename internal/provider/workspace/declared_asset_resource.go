@@ -0,0 +1,212 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/provider/asset"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &declaredAssetResource{}
+	_ resource.ResourceWithConfigure = &declaredAssetResource{}
+)
+
+func newDeclaredAssetResource() resource.Resource {
+	return &declaredAssetResource{}
+}
+
+type declaredAssetResource struct {
+	client *sifflet.ClientWithResponses
+}
+
+// Metadata returns the resource type name.
+func (r *declaredAssetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_declared_asset"
+}
+
+// Schema defines the schema for the resource.
+func (r *declaredAssetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := declaredAssetAttributes()
+	attributes["uri"] = schema.StringAttribute{
+		Description: "URI identifying the declared asset. More about URIs here: https://docs.siffletdata.com/docs/uris. Updates require recreating the declared asset.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["id"] = schema.StringAttribute{
+		Description: "The ID of the asset in the Sifflet catalog.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["workspace"] = schema.StringAttribute{
+		Description: "The name of the declarative workspace in which the provider declares the asset. This workspace is managed by the provider and shouldn't be modified through other means.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "A single Sifflet declared asset.",
+		MarkdownDescription: "A single Sifflet declared asset. Declared assets are assets that are not ingested from a source, such as homegrown pipelines or APIs. " +
+			"See the [Sifflet documentation about declared assets](https://docs.siffletdata.com/docs/declarative-assets) for more information.\n\n" +
+			"Each declared asset is synced in its own declarative workspace, managed by the provider. Use the `sifflet_workspace` resource instead to manage several declared assets, sources and lineage links together. " +
+			"A given asset must not be declared both by this resource and by a `sifflet_workspace` resource.",
+		Attributes: attributes,
+	}
+}
+
+// apply syncs the declared asset in its workspace, and returns the new state.
+func (r *declaredAssetResource) apply(ctx context.Context, plan declaredAssetResourceModel, summary string) (declaredAssetResourceModel, diag.Diagnostics) {
+	newState := plan
+	newState.Workspace = types.StringValue(providerWorkspaceName("asset", plan.Uri.ValueString()))
+
+	payload, diags := newState.ToUpdateDto(ctx)
+	if diags.HasError() {
+		return declaredAssetResourceModel{}, diags
+	}
+
+	_, diags = syncWorkspace(ctx, r.client, payload, false, summary)
+	if diags.HasError() {
+		return declaredAssetResourceModel{}, diags
+	}
+
+	assetDto, found, diags := asset.GetAsset(ctx, r.client, plan.Uri.ValueString(), summary)
+	if diags.HasError() {
+		return declaredAssetResourceModel{}, diags
+	}
+	if !found {
+		diags.AddError(summary, fmt.Sprintf("Asset %s was not found after being declared", plan.Uri.ValueString()))
+		return declaredAssetResourceModel{}, diags
+	}
+	newState.Id = types.StringValue(assetDto.Id.String())
+	return newState, diag.Diagnostics{}
+}
+
+func (r *declaredAssetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := tfutils.WithDefaultCreateTimeout(ctx)
+	defer cancel()
+
+	var plan declaredAssetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.apply(ctx, plan, "Unable to create declared asset")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *declaredAssetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var state declaredAssetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API does not allow reading back the declared attributes of the asset. Only check that the asset still exists.
+	assetDto, found, diags := asset.GetAsset(ctx, r.client, state.Uri.ValueString(), "Unable to read declared asset")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Id = types.StringValue(assetDto.Id.String())
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *declaredAssetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := tfutils.WithDefaultUpdateTimeout(ctx)
+	defer cancel()
+
+	var plan declaredAssetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.apply(ctx, plan, "Unable to update declared asset")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *declaredAssetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := tfutils.WithDefaultDeleteTimeout(ctx)
+	defer cancel()
+
+	var state declaredAssetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = deleteWorkspace(ctx, r.client, state.Workspace.ValueString(), "Unable to delete declared asset")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *declaredAssetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Client
+}
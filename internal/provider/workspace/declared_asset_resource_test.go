@@ -0,0 +1,62 @@
+package workspace_test
+
+import (
+	"fmt"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeclaredAssetResource(t *testing.T) {
+	assetUri := providertests.RandomGithubDeclaredAssetUri()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_declared_asset" "test" {
+						uri = "%s"
+						name = "Terraform test asset"
+						type = "Generic"
+						sub_type = "TerraformTest"
+						description = "Created by Terraform provider tests"
+						tags = [{ name = "Non-Production" }]
+					}
+
+					data "sifflet_asset" "test" {
+						uri = sifflet_declared_asset.test.uri
+					}
+				`, assetUri),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sifflet_declared_asset.test", "id"),
+					resource.TestCheckResourceAttrSet("sifflet_declared_asset.test", "workspace"),
+					resource.TestCheckResourceAttrPair("sifflet_declared_asset.test", "id", "data.sifflet_asset.test", "id"),
+					resource.TestCheckResourceAttr("data.sifflet_asset.test", "name", "Terraform test asset"),
+					resource.TestCheckResourceAttr("data.sifflet_asset.test", "description", "Created by Terraform provider tests"),
+				),
+			},
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_declared_asset" "test" {
+						uri = "%s"
+						name = "Terraform test asset"
+						type = "Generic"
+						sub_type = "TerraformTest"
+						description = "Updated by Terraform provider tests"
+					}
+
+					data "sifflet_asset" "test" {
+						uri = sifflet_declared_asset.test.uri
+					}
+				`, assetUri),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("sifflet_declared_asset.test", "id", "data.sifflet_asset.test", "id"),
+					resource.TestCheckResourceAttr("data.sifflet_asset.test", "description", "Updated by Terraform provider tests"),
+				),
+			},
+		},
+	})
+}
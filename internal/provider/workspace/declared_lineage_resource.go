@@ -0,0 +1,157 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource              = &declaredLineageResource{}
+	_ resource.ResourceWithConfigure = &declaredLineageResource{}
+)
+
+func newDeclaredLineageResource() resource.Resource {
+	return &declaredLineageResource{}
+}
+
+type declaredLineageResource struct {
+	client *sifflet.ClientWithResponses
+}
+
+// Metadata returns the resource type name.
+func (r *declaredLineageResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_declared_lineage"
+}
+
+// Schema defines the schema for the resource.
+func (r *declaredLineageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A lineage link between two Sifflet assets.",
+		MarkdownDescription: "A lineage link between two Sifflet assets. Assets can be declared assets or assets ingested from a source. " +
+			"Use this resource to add lineage links that Sifflet can't discover by itself (for instance, to or from a reverse-ETL job). " +
+			"See the [Sifflet documentation about declared assets](https://docs.siffletdata.com/docs/declarative-assets) for more information.\n\n" +
+			"Each lineage link is synced in its own declarative workspace, managed by the provider. Use the `sifflet_workspace` resource instead to manage several declared assets, sources and lineage links together.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the lineage link. Same as the workspace name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"from": schema.StringAttribute{
+				Description: "URI of the upstream asset. More about URIs here: https://docs.siffletdata.com/docs/uris. Updates require recreating the lineage link.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"to": schema.StringAttribute{
+				Description: "URI of the downstream asset. More about URIs here: https://docs.siffletdata.com/docs/uris. Updates require recreating the lineage link.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace": schema.StringAttribute{
+				Description: "The name of the declarative workspace in which the provider declares the lineage link. This workspace is managed by the provider and shouldn't be modified through other means.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *declaredLineageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := tfutils.WithDefaultCreateTimeout(ctx)
+	defer cancel()
+
+	var plan declaredLineageResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := providerWorkspaceName("lineage", plan.From.ValueString(), plan.To.ValueString())
+	plan.Workspace = types.StringValue(workspace)
+	plan.Id = types.StringValue(workspace)
+
+	payload, diags := plan.ToCreateDto(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags = syncWorkspace(ctx, r.client, payload, false, "Unable to create lineage link")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *declaredLineageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The API does not allow reading back declared lineage links, the state is kept as is.
+}
+
+func (r *declaredLineageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so this is never called.
+	resp.Diagnostics.AddError(
+		"Unexpected update of a lineage link",
+		"Lineage links can't be updated in place. Please report this issue to the provider developers.",
+	)
+}
+
+func (r *declaredLineageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := tfutils.WithDefaultDeleteTimeout(ctx)
+	defer cancel()
+
+	var state declaredLineageResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = deleteWorkspace(ctx, r.client, state.Workspace.ValueString(), "Unable to delete lineage link")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *declaredLineageResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Client
+}
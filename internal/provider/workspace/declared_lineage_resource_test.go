@@ -0,0 +1,66 @@
+package workspace_test
+
+import (
+	"fmt"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDeclaredLineageResource(t *testing.T) {
+	upstreamUri := providertests.RandomGithubDeclaredAssetUri()
+	downstreamUri := providertests.RandomGithubDeclaredAssetUri()
+	otherDownstreamUri := providertests.RandomGithubDeclaredAssetUri()
+
+	assetsConfig := fmt.Sprintf(`
+		resource "sifflet_declared_asset" "upstream" {
+			uri = "%s"
+			type = "Pipeline"
+		}
+
+		resource "sifflet_declared_asset" "downstream" {
+			uri = "%s"
+			type = "Generic"
+			sub_type = "TerraformTest"
+		}
+
+		resource "sifflet_declared_asset" "other_downstream" {
+			uri = "%s"
+			type = "Generic"
+			sub_type = "TerraformTest"
+		}
+	`, upstreamUri, downstreamUri, otherDownstreamUri)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + assetsConfig + `
+					resource "sifflet_declared_lineage" "test" {
+						from = sifflet_declared_asset.upstream.uri
+						to = sifflet_declared_asset.downstream.uri
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_declared_lineage.test", "from", upstreamUri),
+					resource.TestCheckResourceAttr("sifflet_declared_lineage.test", "to", downstreamUri),
+					resource.TestCheckResourceAttrSet("sifflet_declared_lineage.test", "workspace"),
+					resource.TestCheckResourceAttrPair("sifflet_declared_lineage.test", "id", "sifflet_declared_lineage.test", "workspace"),
+				),
+			},
+			{
+				Config: providertests.ProviderConfig() + assetsConfig + `
+					resource "sifflet_declared_lineage" "test" {
+						from = sifflet_declared_asset.upstream.uri
+						to = sifflet_declared_asset.other_downstream.uri
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_declared_lineage.test", "to", otherDownstreamUri),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,370 @@
+package workspace
+
+import (
+	"context"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/model"
+	"terraform-provider-sifflet/internal/provider/asset"
+	"terraform-provider-sifflet/internal/provider/tag"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type workspaceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Sources  types.List   `tfsdk:"sources"`
+	Assets   types.List   `tfsdk:"assets"`
+	Lineages types.List   `tfsdk:"lineages"`
+	Changes  types.List   `tfsdk:"changes"`
+}
+
+var (
+	_ model.CreatableModel[sifflet.PublicDeclarativePayloadDto] = &workspaceModel{}
+	_ model.UpdatableModel[sifflet.PublicDeclarativePayloadDto] = &workspaceModel{}
+)
+
+// hasSameContent returns true if both models would result in the same payload being sent to the API.
+func (m workspaceModel) hasSameContent(other workspaceModel) bool {
+	return m.Name.Equal(other.Name) &&
+		m.Sources.Equal(other.Sources) &&
+		m.Assets.Equal(other.Assets) &&
+		m.Lineages.Equal(other.Lineages)
+}
+
+func (m workspaceModel) getSourcesDto(ctx context.Context) (*[]sifflet.PublicDeclarativeSourceDto, diag.Diagnostics) {
+	if m.Sources.IsNull() {
+		return nil, diag.Diagnostics{}
+	}
+	sources := make([]declaredSourceModel, 0, len(m.Sources.Elements()))
+	diags := m.Sources.ElementsAs(ctx, &sources, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	dtos, diags := tfutils.MapWithDiagnostics(sources, func(source declaredSourceModel) (sifflet.PublicDeclarativeSourceDto, diag.Diagnostics) {
+		return source.ToDto(ctx)
+	})
+	return &dtos, diags
+}
+
+func (m workspaceModel) getAssetsDto(ctx context.Context) (*[]sifflet.PublicDeclarativeAssetDto, diag.Diagnostics) {
+	if m.Assets.IsNull() {
+		return nil, diag.Diagnostics{}
+	}
+	assets := make([]declaredAssetModel, 0, len(m.Assets.Elements()))
+	diags := m.Assets.ElementsAs(ctx, &assets, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	dtos, diags := tfutils.MapWithDiagnostics(assets, func(asset declaredAssetModel) (sifflet.PublicDeclarativeAssetDto, diag.Diagnostics) {
+		return asset.ToDto(ctx)
+	})
+	return &dtos, diags
+}
+
+func (m workspaceModel) getLineagesDto(ctx context.Context) (*[]sifflet.PublicDeclarativeLineageDto, diag.Diagnostics) {
+	if m.Lineages.IsNull() {
+		return nil, diag.Diagnostics{}
+	}
+	lineages := make([]declaredLineageModel, 0, len(m.Lineages.Elements()))
+	diags := m.Lineages.ElementsAs(ctx, &lineages, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	dtos, diags := tfutils.MapWithDiagnostics(lineages, func(lineage declaredLineageModel) (sifflet.PublicDeclarativeLineageDto, diag.Diagnostics) {
+		return lineage.ToDto(ctx)
+	})
+	return &dtos, diags
+}
+
+func (m workspaceModel) toDto(ctx context.Context) (sifflet.PublicDeclarativePayloadDto, diag.Diagnostics) {
+	sources, diags := m.getSourcesDto(ctx)
+	if diags.HasError() {
+		return sifflet.PublicDeclarativePayloadDto{}, diags
+	}
+	assets, diags := m.getAssetsDto(ctx)
+	if diags.HasError() {
+		return sifflet.PublicDeclarativePayloadDto{}, diags
+	}
+	lineages, diags := m.getLineagesDto(ctx)
+	if diags.HasError() {
+		return sifflet.PublicDeclarativePayloadDto{}, diags
+	}
+
+	return sifflet.PublicDeclarativePayloadDto{
+		Workspace: m.Name.ValueString(),
+		Sources:   sources,
+		Assets:    assets,
+		Lineages:  lineages,
+	}, diag.Diagnostics{}
+}
+
+// The sync API uses the same payload to create and update a workspace.
+
+func (m workspaceModel) ToCreateDto(ctx context.Context) (sifflet.PublicDeclarativePayloadDto, diag.Diagnostics) {
+	return m.toDto(ctx)
+}
+
+func (m workspaceModel) ToUpdateDto(ctx context.Context) (sifflet.PublicDeclarativePayloadDto, diag.Diagnostics) {
+	return m.toDto(ctx)
+}
+
+// getTagsDto converts a list of tag models to the DTO expected by the API. Returns nil for a null list.
+func getTagsDto(ctx context.Context, tags types.List) (*[]sifflet.PublicTagReferenceDto, diag.Diagnostics) {
+	if tags.IsNull() {
+		return nil, diag.Diagnostics{}
+	}
+	tagModels := make([]tag.PublicApiTagModel, 0, len(tags.Elements()))
+	diags := tags.ElementsAs(ctx, &tagModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	dtos, diags := tfutils.MapWithDiagnostics(tagModels, func(tagModel tag.PublicApiTagModel) (sifflet.PublicTagReferenceDto, diag.Diagnostics) {
+		return tagModel.ToDto(ctx)
+	})
+	return &dtos, diags
+}
+
+type declaredSourceModel struct {
+	Uri         types.String `tfsdk:"uri"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.List   `tfsdk:"tags"`
+}
+
+func (m declaredSourceModel) ToDto(ctx context.Context) (sifflet.PublicDeclarativeSourceDto, diag.Diagnostics) {
+	tags, diags := getTagsDto(ctx, m.Tags)
+	if diags.HasError() {
+		return sifflet.PublicDeclarativeSourceDto{}, diags
+	}
+	return sifflet.PublicDeclarativeSourceDto{
+		Uri:         m.Uri.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueStringPointer(),
+		Tags:        tags,
+	}, diag.Diagnostics{}
+}
+
+// getOwnersDto converts a list of owner models to the DTO expected by the API. Returns nil for a null list.
+func getOwnersDto(ctx context.Context, owners types.List) (*[]sifflet.PublicReferenceByIdOrEmailDto, diag.Diagnostics) {
+	if owners.IsNull() {
+		return nil, diag.Diagnostics{}
+	}
+	ownerModels := make([]asset.PublicApiOwnerModel, 0, len(owners.Elements()))
+	diags := owners.ElementsAs(ctx, &ownerModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	dtos, diags := tfutils.MapWithDiagnostics(ownerModels, func(ownerModel asset.PublicApiOwnerModel) (sifflet.PublicReferenceByIdOrEmailDto, diag.Diagnostics) {
+		return ownerModel.ToDto(ctx)
+	})
+	return &dtos, diags
+}
+
+// getTermsDto converts a list of business term models to the DTO expected by the API. Returns nil for a null list.
+func getTermsDto(ctx context.Context, terms types.List) (*[]sifflet.PublicReferenceByIdOrNameDto, diag.Diagnostics) {
+	if terms.IsNull() {
+		return nil, diag.Diagnostics{}
+	}
+	termModels := make([]asset.PublicApiTermModel, 0, len(terms.Elements()))
+	diags := terms.ElementsAs(ctx, &termModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	dtos, diags := tfutils.MapWithDiagnostics(termModels, func(termModel asset.PublicApiTermModel) (sifflet.PublicReferenceByIdOrNameDto, diag.Diagnostics) {
+		return termModel.ToDto(ctx)
+	})
+	return &dtos, diags
+}
+
+// getCustomMetadataValuesDto converts a list of custom metadata value models to the DTO expected by the API. Returns nil for a null list.
+func getCustomMetadataValuesDto(ctx context.Context, values types.List) (*[]sifflet.PublicDeclarativeAssetDto_CustomMetadataValues_Item, diag.Diagnostics) {
+	if values.IsNull() {
+		return nil, diag.Diagnostics{}
+	}
+	valueModels := make([]asset.PublicApiCustomMetadataValueModel, 0, len(values.Elements()))
+	diags := values.ElementsAs(ctx, &valueModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	dtos, diags := tfutils.MapWithDiagnostics(valueModels, func(valueModel asset.PublicApiCustomMetadataValueModel) (sifflet.PublicDeclarativeAssetDto_CustomMetadataValues_Item, diag.Diagnostics) {
+		var item sifflet.PublicDeclarativeAssetDto_CustomMetadataValues_Item
+		diags := valueModel.WriteDto(&item)
+		return item, diags
+	})
+	return &dtos, diags
+}
+
+type declaredAssetModel struct {
+	Uri                  types.String `tfsdk:"uri"`
+	Name                 types.String `tfsdk:"name"`
+	Type                 types.String `tfsdk:"type"`
+	SubType              types.String `tfsdk:"sub_type"`
+	Href                 types.String `tfsdk:"href"`
+	Description          types.String `tfsdk:"description"`
+	Tags                 types.List   `tfsdk:"tags"`
+	Owners               types.List   `tfsdk:"owners"`
+	Terms                types.List   `tfsdk:"terms"`
+	CustomMetadataValues types.List   `tfsdk:"custom_metadata_values"`
+}
+
+func (m declaredAssetModel) ToDto(ctx context.Context) (sifflet.PublicDeclarativeAssetDto, diag.Diagnostics) {
+	tags, diags := getTagsDto(ctx, m.Tags)
+	if diags.HasError() {
+		return sifflet.PublicDeclarativeAssetDto{}, diags
+	}
+	owners, diags := getOwnersDto(ctx, m.Owners)
+	if diags.HasError() {
+		return sifflet.PublicDeclarativeAssetDto{}, diags
+	}
+	terms, diags := getTermsDto(ctx, m.Terms)
+	if diags.HasError() {
+		return sifflet.PublicDeclarativeAssetDto{}, diags
+	}
+	customMetadataValues, diags := getCustomMetadataValuesDto(ctx, m.CustomMetadataValues)
+	if diags.HasError() {
+		return sifflet.PublicDeclarativeAssetDto{}, diags
+	}
+	return sifflet.PublicDeclarativeAssetDto{
+		Uri:                  m.Uri.ValueString(),
+		Name:                 m.Name.ValueStringPointer(),
+		Type:                 sifflet.PublicDeclarativeAssetDtoType(m.Type.ValueString()),
+		SubType:              m.SubType.ValueStringPointer(),
+		Href:                 m.Href.ValueStringPointer(),
+		Description:          m.Description.ValueStringPointer(),
+		Tags:                 tags,
+		Owners:               owners,
+		Terms:                terms,
+		CustomMetadataValues: customMetadataValues,
+	}, diag.Diagnostics{}
+}
+
+// declaredAssetResourceModel is the state of a sifflet_declared_asset resource: a single declared asset, synced in its own workspace.
+type declaredAssetResourceModel struct {
+	declaredAssetModel
+	Id        types.String `tfsdk:"id"`
+	Workspace types.String `tfsdk:"workspace"`
+}
+
+var (
+	_ model.CreatableModel[sifflet.PublicDeclarativePayloadDto] = &declaredAssetResourceModel{}
+	_ model.UpdatableModel[sifflet.PublicDeclarativePayloadDto] = &declaredAssetResourceModel{}
+)
+
+func (m declaredAssetResourceModel) toDto(ctx context.Context) (sifflet.PublicDeclarativePayloadDto, diag.Diagnostics) {
+	assetDto, diags := m.declaredAssetModel.ToDto(ctx)
+	if diags.HasError() {
+		return sifflet.PublicDeclarativePayloadDto{}, diags
+	}
+	return sifflet.PublicDeclarativePayloadDto{
+		Workspace: m.Workspace.ValueString(),
+		Assets:    &[]sifflet.PublicDeclarativeAssetDto{assetDto},
+	}, diag.Diagnostics{}
+}
+
+func (m declaredAssetResourceModel) ToCreateDto(ctx context.Context) (sifflet.PublicDeclarativePayloadDto, diag.Diagnostics) {
+	return m.toDto(ctx)
+}
+
+func (m declaredAssetResourceModel) ToUpdateDto(ctx context.Context) (sifflet.PublicDeclarativePayloadDto, diag.Diagnostics) {
+	return m.toDto(ctx)
+}
+
+type declaredLineageModel struct {
+	From types.String `tfsdk:"from"`
+	To   types.String `tfsdk:"to"`
+}
+
+func (m declaredLineageModel) ToDto(_ context.Context) (sifflet.PublicDeclarativeLineageDto, diag.Diagnostics) {
+	return sifflet.PublicDeclarativeLineageDto{
+		From: m.From.ValueString(),
+		To:   m.To.ValueString(),
+	}, diag.Diagnostics{}
+}
+
+// declaredLineageResourceModel is the state of a sifflet_declared_lineage resource: a single lineage link, synced in its own workspace.
+type declaredLineageResourceModel struct {
+	declaredLineageModel
+	Id        types.String `tfsdk:"id"`
+	Workspace types.String `tfsdk:"workspace"`
+}
+
+var (
+	_ model.CreatableModel[sifflet.PublicDeclarativePayloadDto] = &declaredLineageResourceModel{}
+)
+
+func (m declaredLineageResourceModel) ToCreateDto(ctx context.Context) (sifflet.PublicDeclarativePayloadDto, diag.Diagnostics) {
+	lineageDto, diags := m.declaredLineageModel.ToDto(ctx)
+	if diags.HasError() {
+		return sifflet.PublicDeclarativePayloadDto{}, diags
+	}
+	return sifflet.PublicDeclarativePayloadDto{
+		Workspace: m.Workspace.ValueString(),
+		Lineages:  &[]sifflet.PublicDeclarativeLineageDto{lineageDto},
+	}, diag.Diagnostics{}
+}
+
+// changeModel is a change reported by the sync API for an object of the workspace.
+type changeModel struct {
+	Kind       types.String `tfsdk:"kind"`
+	Id         types.String `tfsdk:"id"`
+	ChangeType types.String `tfsdk:"change_type"`
+	Status     types.String `tfsdk:"status"`
+}
+
+var (
+	_ model.ReadableModel[sifflet.WorkspaceApplyObjectResponseDto] = &changeModel{}
+)
+
+func (m changeModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"kind":        types.StringType,
+		"id":          types.StringType,
+		"change_type": types.StringType,
+		"status":      types.StringType,
+	}
+}
+
+func (m *changeModel) FromDto(_ context.Context, dto sifflet.WorkspaceApplyObjectResponseDto) diag.Diagnostics {
+	var id, changeType, status *string
+	if dto.Id != nil {
+		s := dto.Id.String()
+		id = &s
+	}
+	if dto.Change != nil && dto.Change.Type != nil {
+		s := string(*dto.Change.Type)
+		changeType = &s
+	}
+	if dto.Status != nil {
+		s := string(*dto.Status)
+		status = &s
+	}
+	m.Kind = types.StringPointerValue(dto.Kind)
+	m.Id = types.StringPointerValue(id)
+	m.ChangeType = types.StringPointerValue(changeType)
+	m.Status = types.StringPointerValue(status)
+	return diag.Diagnostics{}
+}
+
+// newChangesFromDto returns the list of changes from a sync API response, omitting objects that are left untouched.
+func newChangesFromDto(ctx context.Context, dto sifflet.WorkspaceApplyResponseDto) (types.List, diag.Diagnostics) {
+	changes := make([]changeModel, 0)
+	if dto.Changes != nil {
+		for _, changeDto := range *dto.Changes {
+			if changeDto.Change != nil && changeDto.Change.Type != nil && *changeDto.Change.Type == sifflet.None {
+				continue
+			}
+			var change changeModel
+			diags := change.FromDto(ctx, changeDto)
+			if diags.HasError() {
+				return types.ListNull(types.ObjectType{AttrTypes: changeModel{}.AttributeTypes()}), diags
+			}
+			changes = append(changes, change)
+		}
+	}
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: changeModel{}.AttributeTypes()}, changes)
+}
@@ -0,0 +1,18 @@
+package workspace
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func Resources() []func() resource.Resource {
+	return []func() resource.Resource{
+		newWorkspaceResource,
+		newDeclaredAssetResource,
+		newDeclaredLineageResource,
+	}
+}
+
+func DataSources() []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
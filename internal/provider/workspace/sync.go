@@ -0,0 +1,103 @@
+package workspace
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+
+	sifflet "terraform-provider-sifflet/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// providerWorkspaceName returns the name of a workspace owned by the provider, for a resource that declares a single object.
+// Each of these resources syncs its object in its own workspace, since syncing a workspace replaces its whole content.
+// The name is derived from the kind of the object and from the keys identifying it.
+func providerWorkspaceName(kind string, keys ...string) string {
+	hash := sha256.Sum256([]byte(strings.Join(keys, "\n")))
+	return fmt.Sprintf("terraform-declared-%s-%x", kind, hash[:8])
+}
+
+// syncWorkspace sends a declarative payload to the sync API. The workspace content is entirely replaced by the payload:
+// objects that were previously declared in the workspace but are absent from the payload are deleted.
+//
+// When dryRun is true, the API only reports the changes that would be applied.
+// The API reports errors for individual objects in the response body even when the request succeeds, these errors
+// are converted to diagnostics.
+func syncWorkspace(ctx context.Context, client *sifflet.ClientWithResponses, payload sifflet.PublicDeclarativePayloadDto, dryRun bool, summary string) (sifflet.WorkspaceApplyResponseDto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := sifflet.PublicSyncAssetsParams{
+		DryRun: &dryRun,
+	}
+	syncResponse, err := client.PublicSyncAssetsWithResponse(ctx, &params, payload)
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return sifflet.WorkspaceApplyResponseDto{}, diags
+	}
+
+	if syncResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, syncResponse.StatusCode(), syncResponse.Body)
+		return sifflet.WorkspaceApplyResponseDto{}, diags
+	}
+
+	diags.Append(objectErrorsToDiags(*syncResponse.JSON200, summary)...)
+	return *syncResponse.JSON200, diags
+}
+
+// deleteWorkspace deletes a workspace and all the objects declared in it.
+func deleteWorkspace(ctx context.Context, client *sifflet.ClientWithResponses, name string, summary string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	dryRun := false
+	deleteResponse, err := client.PublicDeleteWorkspaceWithResponse(ctx, name, &sifflet.PublicDeleteWorkspaceParams{DryRun: &dryRun})
+	if err != nil {
+		diags.AddError(summary, err.Error())
+		return diags
+	}
+
+	if deleteResponse.StatusCode() != http.StatusOK {
+		sifflet.HandleHttpErrorAsProblem(ctx, &diags, summary, deleteResponse.StatusCode(), deleteResponse.Body)
+		return diags
+	}
+
+	diags.Append(objectErrorsToDiags(*deleteResponse.JSON200, summary)...)
+	return diags
+}
+
+func objectErrorsToDiags(dto sifflet.WorkspaceApplyResponseDto, summary string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if dto.Changes == nil {
+		return diags
+	}
+
+	for _, change := range *dto.Changes {
+		if change.Status == nil || *change.Status == sifflet.WorkspaceApplyObjectResponseDtoStatusOK {
+			continue
+		}
+
+		messages := make([]string, 0)
+		if change.Logs != nil {
+			for _, log := range *change.Logs {
+				if log.Message != nil {
+					messages = append(messages, *log.Message)
+				}
+			}
+		}
+		kind := "object"
+		if change.Kind != nil {
+			kind = *change.Kind
+		}
+		subStatus := ""
+		if change.SubStatus != nil {
+			subStatus = fmt.Sprintf(" (%s)", *change.SubStatus)
+		}
+		diags.AddError(
+			summary,
+			fmt.Sprintf("%s: %s%s. Details: %s", kind, *change.Status, subStatus, strings.Join(messages, "; ")),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,337 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-sifflet/internal/apiclients"
+	sifflet "terraform-provider-sifflet/internal/client"
+	"terraform-provider-sifflet/internal/provider/asset"
+	"terraform-provider-sifflet/internal/tfutils"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+var (
+	_ resource.Resource               = &workspaceResource{}
+	_ resource.ResourceWithConfigure  = &workspaceResource{}
+	_ resource.ResourceWithModifyPlan = &workspaceResource{}
+)
+
+func newWorkspaceResource() resource.Resource {
+	return &workspaceResource{}
+}
+
+type workspaceResource struct {
+	client *sifflet.ClientWithResponses
+}
+
+// Metadata returns the resource type name.
+func (r *workspaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace"
+}
+
+// declaredAssetAttributes returns the attributes of a declared asset, shared by the workspace and declared asset resources.
+func declaredAssetAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"uri": schema.StringAttribute{
+			Description: "URI identifying the declared asset. More about URIs here: https://docs.siffletdata.com/docs/uris.",
+			Required:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Display name of the declared asset.",
+			Optional:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "Primary type of the declared asset. One of 'Dashboard', 'Dataset', 'Generic', 'MlModel', 'Pipeline'.",
+			Required:    true,
+		},
+		"sub_type": schema.StringAttribute{
+			Description: "Secondary type of the declared asset, shown in the data catalog (for instance, 'View' for a 'Dataset'). For 'Generic' assets, the sub type is used as the asset type in the data catalog filters.",
+			Optional:    true,
+		},
+		"href": schema.StringAttribute{
+			Description: "External link associated with the declared asset (for instance, a link to the actual dashboard).",
+			Optional:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Description of the declared asset.",
+			Optional:    true,
+		},
+		"tags":                   asset.TagsResourceAttribute("Tags associated with the declared asset."),
+		"owners":                 asset.OwnersResourceAttribute("Owners of the declared asset."),
+		"terms":                  asset.TermsResourceAttribute("Business terms associated with the declared asset."),
+		"custom_metadata_values": asset.CustomMetadataValuesResourceAttribute("Custom metadata values of the declared asset."),
+	}
+}
+
+func workspaceResourceSchema() schema.Schema {
+	return schema.Schema{
+		Description: "A Sifflet declarative workspace.",
+		MarkdownDescription: "A Sifflet declarative workspace. A workspace holds declared assets (assets that are not ingested from a source, such as homegrown pipelines or APIs), declared sources and lineage links between assets. See the [Sifflet documentation about declared assets](https://docs.siffletdata.com/docs/declarative-assets) for more information.\n\n" +
+			"This resource owns the whole content of the workspace: objects declared in the workspace outside of this resource (for instance, through the API) are removed when the resource is applied. " +
+			"During planning, the provider sends the workspace content to the Sifflet API in dry-run mode, and reports the changes that would be applied in the `changes` attribute.\n\n" +
+			"The Sifflet API doesn't allow reading back the content of a workspace: changes made outside of Terraform are not detected, but they are overwritten on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the workspace. Same as the workspace name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the workspace. Must be unique across the Sifflet instance. Updates require recreating the workspace.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sources": schema.ListNestedAttribute{
+				Description: "Declared sources. Declaring a source is optional: it's useful to attach metadata (such as a name or a description) to the source. If no source is declared, Sifflet automatically adds declared assets to sources based on their URIs.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uri": schema.StringAttribute{
+							Description: "URI identifying the declared source. More about URIs here: https://docs.siffletdata.com/docs/uris.",
+							Required:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Display name of the declared source.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the declared source.",
+							Optional:    true,
+						},
+						"tags": asset.TagsResourceAttribute("Tags associated with the declared source."),
+					},
+				},
+			},
+			"assets": schema.ListNestedAttribute{
+				Description: "Declared assets.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: declaredAssetAttributes(),
+				},
+			},
+			"lineages": schema.ListNestedAttribute{
+				Description: "Lineage links between assets. Assets can be declared assets or assets ingested from a source.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"from": schema.StringAttribute{
+							Description: "URI of the upstream asset.",
+							Required:    true,
+						},
+						"to": schema.StringAttribute{
+							Description: "URI of the downstream asset.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"changes": schema.ListNestedAttribute{
+				Description: "Changes reported by the Sifflet API when planning the last update of the workspace. Objects left untouched are not included.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							Description: "Kind of the changed object (such as 'Asset', 'Source' or 'Lineage').",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "ID of the changed object, when it already exists.",
+							Computed:    true,
+						},
+						"change_type": schema.StringAttribute{
+							Description: "Type of change (such as 'Create', 'Update' or 'Delete').",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the change reported by the API.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *workspaceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = workspaceResourceSchema()
+}
+
+// ModifyPlan sends the planned workspace content to the API in dry-run mode, to report changes (and errors) at plan time.
+func (r *workspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// The resource is being destroyed
+		return
+	}
+	if r.client == nil || !req.Config.Raw.IsFullyKnown() {
+		// The provider is not configured yet, or the content depends on values that are only known after apply.
+		// The changes are left unknown.
+		return
+	}
+
+	ctx, cancel := tfutils.WithDefaultReadTimeout(ctx)
+	defer cancel()
+
+	var plan workspaceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state workspaceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.hasSameContent(state) {
+			// Nothing to apply, keep the changes reported during the last update
+			plan.Changes = state.Changes
+			resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+			return
+		}
+	}
+
+	payload, diags := plan.ToUpdateDto(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	syncResponse, diags := syncWorkspace(ctx, r.client, payload, true, "Unable to plan workspace changes")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Changes, diags = newChangesFromDto(ctx, syncResponse)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// apply syncs the planned workspace content, and returns the new state.
+func (r *workspaceResource) apply(ctx context.Context, plan workspaceModel, summary string) (workspaceModel, diag.Diagnostics) {
+	payload, diags := plan.ToUpdateDto(ctx)
+	if diags.HasError() {
+		return workspaceModel{}, diags
+	}
+
+	syncResponse, diags := syncWorkspace(ctx, r.client, payload, false, summary)
+	if diags.HasError() {
+		return workspaceModel{}, diags
+	}
+
+	newState := plan
+	newState.Id = plan.Name
+	if plan.Changes.IsUnknown() {
+		// The changes could not be computed during planning
+		newState.Changes, diags = newChangesFromDto(ctx, syncResponse)
+		if diags.HasError() {
+			return workspaceModel{}, diags
+		}
+	}
+	return newState, diag.Diagnostics{}
+}
+
+func (r *workspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := tfutils.WithDefaultCreateTimeout(ctx)
+	defer cancel()
+
+	var plan workspaceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.apply(ctx, plan, "Unable to create workspace")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The API does not allow reading back the content of a workspace, the state is kept as is.
+}
+
+func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := tfutils.WithDefaultUpdateTimeout(ctx)
+	defer cancel()
+
+	var plan workspaceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, diags := r.apply(ctx, plan, "Unable to update workspace")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := tfutils.WithDefaultDeleteTimeout(ctx)
+	defer cancel()
+
+	var state workspaceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = deleteWorkspace(ctx, r.client, state.Name.ValueString(), "Unable to delete workspace")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workspaceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*apiclients.HttpClients)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *HttpClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.Client
+}
@@ -0,0 +1,117 @@
+package workspace_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-sifflet/internal/provider"
+	"terraform-provider-sifflet/internal/provider/providertests"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkspaceResource(t *testing.T) {
+	workspaceName := providertests.RandomName()
+	assetUri := providertests.RandomGithubDeclaredAssetUri()
+	secondAssetUri := providertests.RandomGithubDeclaredAssetUri()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_workspace" "test" {
+						name = "%s"
+						assets = [{
+							uri = "%s"
+							name = "%s"
+							type = "Generic"
+							sub_type = "TerraformTest"
+							description = "Created by Terraform provider tests"
+							tags = [{ name = "Non-Production" }]
+						}]
+					}
+
+					data "sifflet_asset" "test" {
+						uri = sifflet_workspace.test.assets[0].uri
+					}
+				`, workspaceName, assetUri, assetUri),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_workspace.test", "id", workspaceName),
+					resource.TestCheckResourceAttr("sifflet_workspace.test", "name", workspaceName),
+					resource.TestCheckResourceAttr("sifflet_workspace.test", "assets.#", "1"),
+					resource.TestCheckResourceAttrSet("sifflet_workspace.test", "changes.#"),
+					resource.TestCheckResourceAttr("data.sifflet_asset.test", "name", assetUri),
+					resource.TestCheckResourceAttr("data.sifflet_asset.test", "description", "Created by Terraform provider tests"),
+				),
+			},
+			{
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_workspace" "test" {
+						name = "%s"
+						assets = [
+							{
+								uri = "%s"
+								type = "Generic"
+								sub_type = "TerraformTest"
+							},
+							{
+								uri = "%s"
+								type = "Pipeline"
+							},
+						]
+						lineages = [{
+							from = "%s"
+							to = "%s"
+						}]
+					}
+				`, workspaceName, assetUri, secondAssetUri, assetUri, secondAssetUri),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sifflet_workspace.test", "assets.#", "2"),
+					resource.TestCheckResourceAttr("sifflet_workspace.test", "lineages.#", "1"),
+					resource.TestCheckResourceAttr("sifflet_workspace.test", "lineages.0.from", assetUri),
+					resource.TestCheckResourceAttr("sifflet_workspace.test", "lineages.0.to", secondAssetUri),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkspaceInvalidConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Both name and id specified in tag
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_workspace" "test" {
+						name = "%s"
+						assets = [{
+							uri = "%s"
+							type = "Generic"
+							tags = [{
+								name = "Non-Production"
+								id = "00000000-0000-0000-0000-000000000000"
+							}]
+						}]
+					}
+				`, providertests.RandomName(), providertests.RandomGithubDeclaredAssetUri()),
+				ExpectError: regexp.MustCompile(`2 attributes specified when one \(and only one\) of`),
+			},
+			{
+				// The API rejects the payload, the error is reported during planning
+				Config: providertests.ProviderConfig() + fmt.Sprintf(`
+					resource "sifflet_workspace" "test" {
+						name = "%s"
+						assets = [{
+							uri = "%s"
+							type = "NotAType"
+						}]
+					}
+				`, providertests.RandomName(), providertests.RandomGithubDeclaredAssetUri()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Unable to plan workspace changes"),
+			},
+		},
+	})
+}
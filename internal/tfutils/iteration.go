@@ -1,6 +1,10 @@
 package tfutils
 
-import "github.com/hashicorp/terraform-plugin-framework/diag"
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
 
 // MapWithDiagnostics applies a function that can return diagnostics to each element of a collection, and return the results and accumulated diagnostics.
 func MapWithDiagnostics[T any, R any](collection []T, f func(T) (R, diag.Diagnostics)) ([]R, diag.Diagnostics) {
@@ -13,3 +17,33 @@ func MapWithDiagnostics[T any, R any](collection []T, f func(T) (R, diag.Diagnos
 	}
 	return result, diags
 }
+
+// FindByName returns the only element of a collection whose name, as returned by nameOf, is the given name.
+// It fails if there is no such element, or if several elements have this name. The noun (e.g. "source") is used in error messages.
+func FindByName[T any](collection []T, name string, nameOf func(T) (string, error), noun string, summary string) (T, diag.Diagnostics) {
+	var zero T
+	diags := diag.Diagnostics{}
+
+	var matches []T
+	for _, item := range collection {
+		itemName, err := nameOf(item)
+		if err != nil {
+			diags.AddError(summary, err.Error())
+			return zero, diags
+		}
+		if itemName == name {
+			matches = append(matches, item)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError(summary, fmt.Sprintf("No %s named %q was found", noun, name))
+		return zero, diags
+	case 1:
+		return matches[0], diags
+	default:
+		diags.AddError(summary, fmt.Sprintf("%d %ss are named %q. Use the %s ID instead.", len(matches), noun, name, noun))
+		return zero, diags
+	}
+}